@@ -0,0 +1,297 @@
+package fwdp
+
+/*
+#include "../../csrc/fwdp/fwd.h"
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/usnistgov/ndn-dpdk/core/urcu"
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndni"
+	"golang.org/x/exp/slices"
+)
+
+// AclAction indicates the action of a packet filter rule.
+type AclAction string
+
+// AclAction values.
+const (
+	AclActAllow     AclAction = "allow"
+	AclActDrop      AclAction = "drop"
+	AclActNack      AclAction = "nack"
+	AclActRateLimit AclAction = "ratelimit"
+)
+
+var aclActionC = map[AclAction]C.FwAclAction{
+	AclActAllow:     C.FwAclActAllow,
+	AclActDrop:      C.FwAclActDrop,
+	AclActNack:      C.FwAclActNack,
+	AclActRateLimit: C.FwAclActRateLimit,
+}
+
+// AclPktType indicates a packet type matched by a packet filter rule.
+type AclPktType string
+
+// AclPktType values.
+const (
+	AclPktInterest AclPktType = "interest"
+	AclPktData     AclPktType = "data"
+	AclPktNack     AclPktType = "nack"
+)
+
+var aclPktTypeC = map[AclPktType]ndni.PktType{
+	AclPktInterest: ndni.PktInterest,
+	AclPktData:     ndni.PktData,
+	AclPktNack:     ndni.PktNack,
+}
+
+// AclRuleConfig describes a packet filter rule.
+// All specified criteria must be satisfied for a packet to match the rule.
+type AclRuleConfig struct {
+	Name       ndn.Name     `json:"name,omitempty" gqldesc:"Name prefix. Empty name matches any name."`
+	Face       iface.ID     `json:"-"`
+	PktTypes   []AclPktType `json:"pktTypes,omitempty" gqldesc:"Packet types. Empty list matches any packet type."`
+	CongMark   *bool        `json:"congMark,omitempty" gqldesc:"If set, match packets with (true) or without (false) congestion mark."`
+	NackReason uint8        `json:"nackReason,omitempty" gqldesc:"If non-zero, match Nacks with this reason only."`
+
+	Action       AclAction `json:"action" gqldesc:"Action on matched packets."`
+	RejectReason uint8     `json:"rejectReason,omitempty" gqldesc:"Nack reason for 'nack' action. Default is no-route."`
+	Rate         float64   `json:"rate,omitempty" gqldesc:"Permitted packets per second for 'ratelimit' action."`
+	Burst        int       `json:"burst,omitempty" gqldesc:"Burst size in packets for 'ratelimit' action. Default is 1."`
+}
+
+func (cfg *AclRuleConfig) applyDefaults() {
+	if cfg.RejectReason == an.NackNone {
+		cfg.RejectReason = an.NackNoRoute
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = 1
+	}
+}
+
+func (cfg AclRuleConfig) validate() error {
+	if value, _ := cfg.Name.MarshalBinary(); len(value) > ndni.NameMaxLength {
+		return errors.New("name too long")
+	}
+	for _, t := range cfg.PktTypes {
+		if _, ok := aclPktTypeC[t]; !ok {
+			return fmt.Errorf("bad packet type %s", t)
+		}
+	}
+	if _, ok := aclActionC[cfg.Action]; !ok {
+		return fmt.Errorf("bad action %s", cfg.Action)
+	}
+	if cfg.Action == AclActRateLimit && !(cfg.Rate > 0) {
+		return errors.New("rate must be positive for ratelimit action")
+	}
+	return nil
+}
+
+func (cfg AclRuleConfig) copyToC(c *C.FwAclRule) {
+	for _, t := range cfg.PktTypes {
+		c.pktTypes |= C.uint8_t(1 << aclPktTypeC[t])
+	}
+	c.face = C.FaceID(cfg.Face)
+	switch {
+	case cfg.CongMark == nil:
+		c.congMark = C.FwAclCongMarkAny
+	case *cfg.CongMark:
+		c.congMark = C.FwAclCongMarkMarked
+	default:
+		c.congMark = C.FwAclCongMarkUnmarked
+	}
+	c.matchReason = C.uint8_t(cfg.NackReason)
+	c.action = aclActionC[cfg.Action]
+	c.nackReason = C.uint8_t(cfg.RejectReason)
+	if cfg.Action == AclActRateLimit {
+		interval := eal.ToTscDuration(time.Duration(float64(time.Second) / cfg.Rate))
+		c.rateInterval = C.TscDuration(interval)
+		c.rateTolerance = C.TscDuration(interval * int64(cfg.Burst-1))
+	}
+}
+
+// AclRuleCounters contains packet filter rule counters.
+type AclRuleCounters struct {
+	NMatched uint64 `json:"nMatched" gqldesc:"Packets matching the rule."`
+	NDropped uint64 `json:"nDropped" gqldesc:"Packets dropped or rejected by the rule."`
+}
+
+// AclRule is a packet filter rule in the forwarder data plane.
+type AclRule struct {
+	AclRuleConfig
+	acl  *Acl
+	id   int
+	slot int
+	gen  uint32
+}
+
+// ID returns rule identifier.
+func (rule *AclRule) ID() int {
+	return rule.id
+}
+
+// Counters retrieves rule counters, summed across forwarding threads.
+func (rule *AclRule) Counters() (cnt AclRuleCounters) {
+	for _, fwd := range rule.acl.fwds {
+		cntC := fwd.c.aclCnt[rule.slot]
+		if uint32(cntC.gen) != rule.gen {
+			continue
+		}
+		cnt.NMatched += uint64(cntC.nMatched)
+		cnt.NDropped += uint64(cntC.nDropped)
+	}
+	return
+}
+
+// Close deletes the rule from the packet filter table.
+func (rule *AclRule) Close() error {
+	return rule.acl.Delete(rule)
+}
+
+// Acl is the packet filter table in the forwarder data plane.
+// Rules are evaluated in order, and the first matching rule determines the action.
+// Packets that do not match any rule are allowed.
+type Acl struct {
+	mutex   sync.Mutex
+	fwds    []*Fwd
+	c       *C.FwAcl
+	rules   []*AclRule
+	slots   [AclMaxRules]bool
+	lastID  int
+	lastGen uint32
+}
+
+// Rules returns a list of rules in evaluation order.
+func (acl *Acl) Rules() []*AclRule {
+	acl.mutex.Lock()
+	defer acl.mutex.Unlock()
+	return slices.Clone(acl.rules)
+}
+
+// Get retrieves a rule by ID.
+func (acl *Acl) Get(id int) *AclRule {
+	acl.mutex.Lock()
+	defer acl.mutex.Unlock()
+	if i := slices.IndexFunc(acl.rules, func(rule *AclRule) bool { return rule.id == id }); i >= 0 {
+		return acl.rules[i]
+	}
+	return nil
+}
+
+// Insert inserts a rule.
+// index is the position in evaluation order; -1 or out-of-range index appends to the end.
+func (acl *Acl) Insert(cfg AclRuleConfig, index int) (rule *AclRule, e error) {
+	cfg.applyDefaults()
+	if e := cfg.validate(); e != nil {
+		return nil, e
+	}
+
+	acl.mutex.Lock()
+	defer acl.mutex.Unlock()
+	slot := slices.Index(acl.slots[:], false)
+	if slot < 0 {
+		return nil, errors.New("too many rules")
+	}
+
+	acl.lastID++
+	acl.lastGen++
+	rule = &AclRule{
+		AclRuleConfig: cfg,
+		acl:           acl,
+		id:            acl.lastID,
+		slot:          slot,
+		gen:           acl.lastGen,
+	}
+	if index < 0 || index > len(acl.rules) {
+		index = len(acl.rules)
+	}
+	rules := slices.Insert(slices.Clone(acl.rules), index, rule)
+	if e := acl.publish(rules); e != nil {
+		return nil, e
+	}
+	acl.slots[slot] = true
+	return rule, nil
+}
+
+// Delete deletes a rule.
+func (acl *Acl) Delete(rule *AclRule) error {
+	acl.mutex.Lock()
+	defer acl.mutex.Unlock()
+	index := slices.Index(acl.rules, rule)
+	if index < 0 {
+		return errors.New("rule not found")
+	}
+
+	rules := slices.Delete(slices.Clone(acl.rules), index, index+1)
+	if e := acl.publish(rules); e != nil {
+		return e
+	}
+	acl.slots[rule.slot] = false
+	return nil
+}
+
+// publish builds a C table from rules and assigns it to forwarding threads.
+// Caller must hold the mutex.
+func (acl *Acl) publish(rules []*AclRule) error {
+	var c *C.FwAcl
+	if len(rules) > 0 {
+		c = eal.Zmalloc[C.FwAcl]("FwAcl", C.sizeof_FwAcl, eal.NumaSocket{})
+		prefixV := unsafe.Slice((*byte)(unsafe.Pointer(&c.prefixV[0])), len(c.prefixV))
+		offset := 0
+		for i, rule := range rules {
+			value, _ := rule.Name.MarshalBinary()
+			if offset+len(value) > len(prefixV) {
+				eal.Free(c)
+				return errors.New("name prefix buffer is full")
+			}
+			ruleC := &c.rules[i]
+			rule.copyToC(ruleC)
+			ruleC.gen = C.uint32_t(rule.gen)
+			ruleC.slot = C.uint8_t(rule.slot)
+			ruleC.prefixOffset = C.uint16_t(offset)
+			ruleC.prefixL = C.uint16_t(copy(prefixV[offset:], value))
+			offset += len(value)
+		}
+		c.nRules = C.uint32_t(len(rules))
+	}
+
+	var old *C.FwAcl
+	for _, fwd := range acl.fwds {
+		old = C.FwAcl_Set(&fwd.c.acl, c)
+	}
+	acl.c, acl.rules = c, rules
+
+	if old != nil {
+		go func() {
+			urcu.Synchronize()
+			eal.Free(old)
+		}()
+	}
+	return nil
+}
+
+// Close releases the table.
+// Forwarding threads must be stopped.
+func (acl *Acl) Close() error {
+	acl.mutex.Lock()
+	defer acl.mutex.Unlock()
+	if acl.c != nil {
+		eal.Free(acl.c)
+		acl.c = nil
+	}
+	acl.rules = nil
+	return nil
+}
+
+func newAcl(fwds []*Fwd) *Acl {
+	return &Acl{fwds: fwds}
+}
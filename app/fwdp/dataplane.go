@@ -40,6 +40,11 @@ type Config struct {
 	Pcct     pcct.Config        `json:"pcct,omitempty"`
 	Suppress pit.SuppressConfig `json:"suppress,omitempty"`
 
+	// Policies contains per-prefix forwarding policies.
+	// A FIB entry selects a policy by its index in this list.
+	// Unlisted policies, including policy 0 if this list is empty, use Suppress and no extra lifetime cap.
+	Policies []PolicyConfig `json:"policies,omitempty"`
+
 	Crypto                CryptoConfig         `json:"crypto,omitempty"`
 	Disk                  DiskConfig           `json:"disk,omitempty"`
 	FwdInterestQueue      iface.PktQueueConfig `json:"fwdInterestQueue,omitempty"`
@@ -55,6 +60,10 @@ func (cfg *Config) validate() error {
 		}
 	}
 
+	if len(cfg.Policies) > fibdef.MaxPolicies {
+		return fmt.Errorf("number of policies cannot exceed %d", fibdef.MaxPolicies)
+	}
+
 	if cfg.FwdDataQueue.DequeueBurstSize <= 0 {
 		cfg.FwdDataQueue.DequeueBurstSize = iface.MaxBurstSize
 	}
@@ -127,6 +136,8 @@ type DataPlane struct {
 	fwcsh    map[eal.NumaSocket]*CryptoShared
 	fwdisk   *Disk
 	fwds     []*Fwd
+	acl      *Acl
+	regions  *NetRegions
 }
 
 // Ndt returns the NDT.
@@ -139,6 +150,16 @@ func (dp *DataPlane) Fib() *fib.Fib {
 	return dp.fib
 }
 
+// Acl returns the packet filter table.
+func (dp *DataPlane) Acl() *Acl {
+	return dp.acl
+}
+
+// NetRegions returns the network region table.
+func (dp *DataPlane) NetRegions() *NetRegions {
+	return dp.regions
+}
+
 // Fwds returns a list of forwarding threads.
 func (dp *DataPlane) Fwds() []*Fwd {
 	return dp.fwds
@@ -180,6 +201,12 @@ func (dp *DataPlane) Close() error {
 		deferFreeLCore(fwd.LCore())
 		errs = append(errs, fwd.Close())
 	}
+	if dp.acl != nil {
+		errs = append(errs, dp.acl.Close())
+	}
+	if dp.regions != nil {
+		errs = append(errs, dp.regions.Close())
+	}
 	for _, fwi := range dp.fwis {
 		errs = append(errs, fwi.Close())
 	}
@@ -234,13 +261,15 @@ func New(cfg Config) (dp *DataPlane, e error) {
 	var fibFwds []fib.LookupThread
 	for i, lc := range lcFwd {
 		fwd, e := newFwd(i, lc, cfg.Pcct, cfg.FwdInterestQueue, cfg.FwdDataQueue, cfg.FwdNackQueue,
-			cfg.LatencySampleInterval, cfg.Suppress)
+			cfg.LatencySampleInterval, cfg.listPolicies())
 		if e != nil {
 			return nil, fmt.Errorf("Fwd[%d].Init(): %w", i, e)
 		}
 		dp.fwds = append(dp.fwds, fwd)
 		fibFwds = append(fibFwds, fwd)
 	}
+	dp.acl = newAcl(dp.fwds)
+	dp.regions = newNetRegions(dp.fwds)
 	if len(eal.Sockets)*ndni.PacketMempool.Config().Capacity < len(dp.fwds)*cfg.Pcct.CsMemoryCapacity {
 		logger.Warn("total DIRECT mempool capacity is less than total CsMemoryCapacity; packet reception will stop when CS is full")
 	}
@@ -316,6 +345,7 @@ func New(cfg Config) (dp *DataPlane, e error) {
 	}
 
 	iface.RxParseFor = ndni.ParseForFw
+	iface.TxResidenceStat = true
 	return dp, nil
 }
 
@@ -0,0 +1,40 @@
+package fwdp
+
+//go:generate go run ../../mk/enumgen/ -guard=NDNDPDK_FWDP_ENUM_H -out=../../csrc/fwdp/enum.h .
+
+const (
+	// AclMaxRules is the maximum number of packet filter rules.
+	AclMaxRules = 64
+
+	// AclPrefixBufLen is the buffer length for name prefixes in packet filter rules.
+	AclPrefixBufLen = 16384
+
+	_ = "enumgen::FwAcl:Acl"
+)
+
+const (
+	aclActionAllow = iota
+	aclActionDrop
+	aclActionNack
+	aclActionRateLimit
+
+	_ = "enumgen:FwAclAction:FwAclAct:aclAction"
+)
+
+const (
+	aclCongMarkAny = iota
+	aclCongMarkUnmarked
+	aclCongMarkMarked
+
+	_ = "enumgen:FwAclCongMark:FwAclCongMark:aclCongMark"
+)
+
+const (
+	// NetRegionMax is the maximum number of entries in the network region table.
+	NetRegionMax = 64
+
+	// NetRegionBufLen is the buffer length for names in the network region table.
+	NetRegionBufLen = 8192
+
+	_ = "enumgen::FwNetRegion:NetRegion"
+)
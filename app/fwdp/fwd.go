@@ -114,7 +114,7 @@ func (Fwd) ThreadRole() string {
 // newFwd creates a forwarding thread.
 // FIB must be assigned before starting the thread.
 func newFwd(id int, lc eal.LCore, pcctCfg pcct.Config, qcfgI, qcfgD, qcfgN iface.PktQueueConfig,
-	latencySampleInterval int, suppressCfg pit.SuppressConfig) (fwd *Fwd, e error) {
+	latencySampleInterval int, policies []PolicyConfig) (fwd *Fwd, e error) {
 	socket := lc.NumaSocket()
 	fwd = &Fwd{
 		id: id,
@@ -151,7 +151,9 @@ func newFwd(id int, lc eal.LCore, pcctCfg pcct.Config, qcfgI, qcfgD, qcfgN iface
 	fwd.c.cs = &pcctC.cs
 
 	pcg32.Init(unsafe.Pointer(&fwd.c.sgRng))
-	suppressCfg.CopyToC(unsafe.Pointer(&fwd.c.suppressCfg))
+	for i, policy := range policies {
+		policy.copyToC(&fwd.c.policies[i])
+	}
 	(*ndni.Mempools)(unsafe.Pointer(&fwd.c.mp)).Assign(socket)
 	fwd.LatencyStat().Init(latencySampleInterval)
 	return fwd, nil
@@ -168,6 +170,12 @@ type FwdCounters struct {
 	NDupNonce     uint64 `json:"nDupNonce" gqldesc:"Interests dropped due to duplicate nonce."`
 	NSgNoFwd      uint64 `json:"nSgNoFwd" gqldesc:"Interests not forwarded by strategy."`
 	NNackMismatch uint64 `json:"nNackMismatch" gqldesc:"Nacks dropped due to outdated nonce."`
+	NScopeRxDrop  uint64 `json:"nScopeRxDrop" gqldesc:"Interests and Data dropped due to /localhost from non-local face."`
+	NScopeTxDrop  uint64 `json:"nScopeTxDrop" gqldesc:"Interests and Data not sent to non-local face due to scope control."`
+
+	NNextHopInterests uint64 `json:"nNextHopInterests" gqldesc:"Interests forwarded per NextHopFaceId, bypassing strategy."`
+
+	Policies []PolicyCounters `json:"policies" gqldesc:"Per-policy counters, indexed by policy."`
 }
 
 // Counters retrieves forwarding thread counters.
@@ -180,6 +188,15 @@ func (fwd *Fwd) Counters() (cnt FwdCounters) {
 	cnt.NDupNonce = uint64(fwd.c.nDupNonce)
 	cnt.NSgNoFwd = uint64(fwd.c.nSgNoFwd)
 	cnt.NNackMismatch = uint64(fwd.c.nNackMismatch)
+	cnt.NScopeRxDrop = uint64(fwd.c.nScopeRxDrop)
+	cnt.NScopeTxDrop = uint64(fwd.c.nScopeTxDrop)
+	cnt.NNextHopInterests = uint64(fwd.c.nNextHopInterests)
+	for _, policyC := range fwd.c.policies {
+		cnt.Policies = append(cnt.Policies, PolicyCounters{
+			NSuppressed:     uint64(policyC.nSuppressed),
+			NLifetimeCapped: uint64(policyC.nLifetimeCapped),
+		})
+	}
 	return cnt
 }
 
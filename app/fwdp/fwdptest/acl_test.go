@@ -0,0 +1,95 @@
+package fwdptest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/app/fwdp"
+	"github.com/usnistgov/ndn-dpdk/iface/intface"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+)
+
+func TestAcl(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := NewFixture(t)
+	acl := fixture.DataPlane.Acl()
+
+	face1, face2 := intface.MustNew(), intface.MustNew()
+	collect1, collect2 := intface.Collect(face1), intface.Collect(face2)
+	fixture.SetFibEntry("/A", "multicast", face2.ID)
+
+	ruleDrop, e := acl.Insert(fwdp.AclRuleConfig{
+		Name:     ndn.ParseName("/A/drop"),
+		PktTypes: []fwdp.AclPktType{fwdp.AclPktInterest},
+		Action:   fwdp.AclActDrop,
+	}, -1)
+	require.NoError(e)
+	ruleNack, e := acl.Insert(fwdp.AclRuleConfig{
+		Name:         ndn.ParseName("/A/nack"),
+		Action:       fwdp.AclActNack,
+		RejectReason: an.NackCongestion,
+	}, -1)
+	require.NoError(e)
+	ruleRate, e := acl.Insert(fwdp.AclRuleConfig{
+		Name:   ndn.ParseName("/A/rate"),
+		Action: fwdp.AclActRateLimit,
+		Rate:   0.01,
+		Burst:  2,
+	}, -1)
+	require.NoError(e)
+	ruleData, e := acl.Insert(fwdp.AclRuleConfig{
+		Name:     ndn.ParseName("/A/data"),
+		Face:     face2.ID,
+		PktTypes: []fwdp.AclPktType{fwdp.AclPktData},
+		Action:   fwdp.AclActDrop,
+	}, 0)
+	require.NoError(e)
+	if rules := acl.Rules(); assert.Len(rules, 4) {
+		assert.Equal(ruleData, rules[0])
+		assert.Equal(ruleDrop, rules[1])
+	}
+
+	face1.Tx <- ndn.MakeInterest("/A/drop/1")
+	fixture.StepDelay()
+	assert.Equal(0, collect2.Count())
+	assert.Equal(0, collect1.Count())
+
+	face1.Tx <- ndn.MakeInterest("/A/nack/1")
+	fixture.StepDelay()
+	assert.Equal(0, collect2.Count())
+	if assert.Equal(1, collect1.Count()) {
+		if packet := collect1.Get(-1); assert.NotNil(packet.Nack) {
+			assert.EqualValues(an.NackCongestion, packet.Nack.Reason)
+		}
+	}
+
+	for i := 0; i < 4; i++ {
+		face1.Tx <- ndn.MakeInterest(fmt.Sprintf("/A/rate/%d", i))
+	}
+	fixture.StepDelay()
+	assert.Equal(2, collect2.Count())
+
+	face1.Tx <- ndn.MakeInterest("/A/data/1")
+	fixture.StepDelay()
+	assert.Equal(3, collect2.Count())
+	face2.Tx <- ndn.MakeData(collect2.Get(-1).Interest)
+	fixture.StepDelay()
+	assert.Equal(1, collect1.Count())
+
+	assert.Equal(fwdp.AclRuleCounters{NMatched: 1, NDropped: 1}, ruleDrop.Counters())
+	assert.Equal(fwdp.AclRuleCounters{NMatched: 1, NDropped: 1}, ruleNack.Counters())
+	assert.Equal(fwdp.AclRuleCounters{NMatched: 4, NDropped: 2}, ruleRate.Counters())
+	assert.Equal(fwdp.AclRuleCounters{NMatched: 1, NDropped: 1}, ruleData.Counters())
+
+	require.NoError(ruleDrop.Close())
+	assert.Nil(acl.Get(ruleDrop.ID()))
+	face1.Tx <- ndn.MakeInterest("/A/drop/2")
+	fixture.StepDelay()
+	assert.Equal(4, collect2.Count())
+
+	_, e = acl.Insert(fwdp.AclRuleConfig{Action: fwdp.AclActRateLimit}, -1)
+	assert.Error(e)
+	_, e = acl.Insert(fwdp.AclRuleConfig{Action: "reject"}, -1)
+	assert.Error(e)
+}
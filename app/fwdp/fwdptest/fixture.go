@@ -92,6 +92,19 @@ func (f *Fixture) SetFibEntry(name string, strategy string, nexthops ...iface.ID
 
 // SetFibEntryParams inserts or replaces a FIB entry with SgInit params.
 func (f *Fixture) SetFibEntryParams(name string, strategy string, params map[string]any, nexthops ...iface.ID) {
+	f.setFibEntry(name, strategy, func(entry *fibdef.Entry) {
+		entry.Params = params
+	}, nexthops...)
+}
+
+// SetFibEntryPolicy inserts or replaces a FIB entry with a forwarding policy.
+func (f *Fixture) SetFibEntryPolicy(name string, strategy string, policy int, nexthops ...iface.ID) {
+	f.setFibEntry(name, strategy, func(entry *fibdef.Entry) {
+		entry.Policy = policy
+	}, nexthops...)
+}
+
+func (f *Fixture) setFibEntry(name string, strategy string, modify func(entry *fibdef.Entry), nexthops ...iface.ID) {
 	sc := strategycode.Find(strategy)
 	if sc == nil {
 		var e error
@@ -100,7 +113,7 @@ func (f *Fixture) SetFibEntryParams(name string, strategy string, params map[str
 	}
 
 	entry := fibtestenv.MakeEntry(name, sc, nexthops...)
-	entry.Params = params
+	modify(&entry)
 	e := f.Fib.Insert(entry)
 	f.require.NoError(e)
 }
@@ -10,6 +10,9 @@ import (
 
 	"github.com/usnistgov/ndn-dpdk/app/fwdp"
 	"github.com/usnistgov/ndn-dpdk/container/cs"
+	"github.com/usnistgov/ndn-dpdk/container/fib/fibdef"
+	"github.com/usnistgov/ndn-dpdk/container/pit"
+	"github.com/usnistgov/ndn-dpdk/core/nnduration"
 	"github.com/usnistgov/ndn-dpdk/core/pciaddr"
 	"github.com/usnistgov/ndn-dpdk/dpdk/bdev"
 	"github.com/usnistgov/ndn-dpdk/dpdk/ealthread"
@@ -214,6 +217,46 @@ func TestInterestSuppress(t *testing.T) {
 	// but this could be off by one on a slower machine.
 }
 
+func TestInterestPolicy(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := NewFixture(t, func(cfg *fwdp.Config) {
+		cfg.Policies = []fwdp.PolicyConfig{
+			{},
+			{
+				Suppress: pit.SuppressConfig{
+					Min: nnduration.Nanoseconds(200 * time.Millisecond),
+					Max: nnduration.Nanoseconds(200 * time.Millisecond),
+				},
+				MaxLifetime: 1000,
+			},
+		}
+	})
+
+	face1, face2, face3 := intface.MustNew(), intface.MustNew(), intface.MustNew()
+	collect3 := intface.Collect(face3)
+	fixture.SetFibEntryPolicy("/A", "multicast", 1, face3.ID)
+
+	face1.Tx <- ndn.MakeInterest("/A/1", 8000*time.Millisecond)
+	fixture.StepDelay()
+	require.Equal(1, collect3.Count())
+	assert.LessOrEqual(collect3.Get(-1).Interest.Lifetime, 1000*time.Millisecond)
+
+	face2.Tx <- ndn.MakeInterest("/A/1", 500*time.Millisecond)
+	fixture.StepDelay()
+	assert.Equal(1, collect3.Count())
+
+	cnt := fwdp.PolicyCounters{}
+	for _, fwd := range fixture.DataPlane.Fwds() {
+		policies := fwd.Counters().Policies
+		require.Len(policies, fibdef.MaxPolicies)
+		assert.Zero(policies[0].NSuppressed)
+		cnt.NSuppressed += policies[1].NSuppressed
+		cnt.NLifetimeCapped += policies[1].NLifetimeCapped
+	}
+	assert.EqualValues(1, cnt.NSuppressed)
+	assert.EqualValues(1, cnt.NLifetimeCapped)
+}
+
 func TestInterestNoRoute(t *testing.T) {
 	assert, _ := makeAR(t)
 	fixture := NewFixture(t)
@@ -486,6 +529,50 @@ func TestFwHint(t *testing.T) {
 	assert.Equal(uint64(1), fibCnt.NTxInterests)
 }
 
+func TestFwHintNetRegion(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := NewFixture(t)
+	regions := fixture.DataPlane.NetRegions()
+
+	face1, face2, face3 := intface.MustNew(), intface.MustNew(), intface.MustNew()
+	collect1, collect2, collect3 := intface.Collect(face1), intface.Collect(face2), intface.Collect(face3)
+	fixture.SetFibEntry("/A", "multicast", face1.ID)
+	fixture.SetFibEntry("/B", "multicast", face2.ID)
+
+	face3.Tx <- ndn.MakeInterest("/A/1", ndn.ForwardingHint{ndn.ParseName("/B")})
+	fixture.StepDelay()
+	assert.Equal(0, collect1.Count())
+	assert.Equal(1, collect2.Count())
+
+	require.NoError(regions.Set([]ndn.Name{ndn.ParseName("/Z"), ndn.ParseName("/B/router")}))
+	assert.Len(regions.List(), 2)
+
+	face3.Tx <- ndn.MakeInterest("/A/2", ndn.ForwardingHint{ndn.ParseName("/C"), ndn.ParseName("/B")})
+	fixture.StepDelay()
+	assert.Equal(1, collect1.Count())
+	assert.Equal(1, collect2.Count())
+
+	face1.Tx <- ndn.MakeData(collect1.Get(-1).Interest)
+	fixture.StepDelay()
+	assert.Equal(1, collect3.Count())
+
+	face3.Tx <- ndn.MakeInterest("/A/3", ndn.ForwardingHint{ndn.ParseName("/B/router/X")})
+	fixture.StepDelay()
+	assert.Equal(1, collect1.Count())
+	assert.Equal(2, collect2.Count())
+
+	require.NoError(regions.Set(nil))
+	assert.Len(regions.List(), 0)
+
+	face3.Tx <- ndn.MakeInterest("/A/4", ndn.ForwardingHint{ndn.ParseName("/B")})
+	fixture.StepDelay()
+	assert.Equal(1, collect1.Count())
+	assert.Equal(3, collect2.Count())
+
+	tooMany := make([]ndn.Name, fwdp.NetRegionMax+1)
+	assert.Error(regions.Set(tooMany))
+}
+
 func TestImplicitDigestSimple(t *testing.T) {
 	assert, require := makeAR(t)
 	fixture := NewFixture(t)
@@ -0,0 +1,72 @@
+package fwdptest
+
+import (
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/app/fwdp"
+	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/iface/intface"
+	"github.com/usnistgov/ndn-dpdk/iface/socketface"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+)
+
+func TestLocalControl(t *testing.T) {
+	assert, _ := makeAR(t)
+	fixture := NewFixture(t)
+
+	local := true
+	faceL := intface.Must(intface.New(socketface.Config{Config: iface.Config{Local: &local}}))
+	faceN, face2, face3 := intface.MustNew(), intface.MustNew(), intface.MustNew()
+	collectL, collectN := intface.Collect(faceL), intface.Collect(faceN)
+	collect2, collect3 := intface.Collect(face2), intface.Collect(face3)
+	fixture.SetFibEntry("/A", "multicast", face2.ID)
+
+	// NextHopFaceId from local face bypasses strategy
+	pkt := ndn.MakeInterest("/A/1").ToPacket()
+	pkt.Lp.NextHopFaceID = uint64(face3.ID)
+	faceL.Tx <- pkt
+	fixture.StepDelay()
+	assert.Equal(0, collect2.Count())
+	if assert.Equal(1, collect3.Count()) {
+		assert.Zero(collect3.Get(-1).Lp.NextHopFaceID)
+		assert.Zero(collect3.Get(-1).Lp.IncomingFaceID)
+	}
+
+	// IncomingFaceId on Data sent to local face
+	face3.Tx <- ndn.MakeData(collect3.Get(-1).Interest)
+	fixture.StepDelay()
+	if assert.Equal(1, collectL.Count()) {
+		assert.EqualValues(face3.ID, collectL.Get(-1).Lp.IncomingFaceID)
+	}
+
+	// NextHopFaceId from non-local face is ignored
+	pkt = ndn.MakeInterest("/A/2").ToPacket()
+	pkt.Lp.NextHopFaceID = uint64(face3.ID)
+	faceN.Tx <- pkt
+	fixture.StepDelay()
+	assert.Equal(1, collect2.Count())
+	assert.Equal(1, collect3.Count())
+
+	// no IncomingFaceId on Data sent to non-local face
+	face2.Tx <- ndn.MakeData(collect2.Get(-1).Interest)
+	fixture.StepDelay()
+	if assert.Equal(1, collectN.Count()) {
+		assert.Zero(collectN.Get(-1).Lp.IncomingFaceID)
+	}
+
+	// IncomingFaceId on Nack sent to local face
+	faceL.Tx <- ndn.MakeInterest("/A/3")
+	fixture.StepDelay()
+	assert.Equal(2, collect2.Count())
+	face2.Tx <- ndn.MakeNack(collect2.Get(-1).Interest)
+	fixture.StepDelay()
+	if assert.Equal(2, collectL.Count()) {
+		packet := collectL.Get(-1)
+		assert.NotNil(packet.Nack)
+		assert.EqualValues(face2.ID, packet.Lp.IncomingFaceID)
+	}
+
+	assert.Equal(uint64(1), fixture.SumCounter(func(fwd *fwdp.Fwd) uint64 {
+		return fwd.Counters().NNextHopInterests
+	}))
+}
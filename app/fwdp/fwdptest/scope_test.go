@@ -0,0 +1,61 @@
+package fwdptest
+
+import (
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/app/fwdp"
+	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/iface/intface"
+	"github.com/usnistgov/ndn-dpdk/iface/socketface"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+)
+
+func TestScope(t *testing.T) {
+	assert, _ := makeAR(t)
+	fixture := NewFixture(t)
+
+	local := true
+	newLocalFace := func() *intface.IntFace {
+		return intface.Must(intface.New(socketface.Config{Config: iface.Config{Local: &local}}))
+	}
+	faceN1, faceN2 := intface.MustNew(), intface.MustNew()
+	faceL1, faceL2 := newLocalFace(), newLocalFace()
+	assert.False(faceN1.D.IsLocal())
+	assert.True(faceL1.D.IsLocal())
+	collectN1, collectN2 := intface.Collect(faceN1), intface.Collect(faceN2)
+	collectL1, collectL2 := intface.Collect(faceL1), intface.Collect(faceL2)
+	fixture.SetFibEntry("/localhost", "multicast", faceN2.ID, faceL2.ID)
+	fixture.SetFibEntry("/localhop", "multicast", faceN2.ID, faceL2.ID)
+
+	faceN1.Tx <- ndn.MakeInterest("/localhost/A/1")
+	fixture.StepDelay()
+	assert.Equal(0, collectN2.Count())
+	assert.Equal(0, collectL2.Count())
+
+	faceL1.Tx <- ndn.MakeInterest("/localhost/A/2")
+	fixture.StepDelay()
+	assert.Equal(0, collectN2.Count())
+	assert.Equal(1, collectL2.Count())
+
+	faceL2.Tx <- ndn.MakeData(collectL2.Get(-1).Interest)
+	fixture.StepDelay()
+	assert.Equal(1, collectL1.Count())
+
+	faceN1.Tx <- ndn.MakeInterest("/localhop/B/1")
+	fixture.StepDelay()
+	assert.Equal(0, collectN2.Count())
+	assert.Equal(2, collectL2.Count())
+
+	faceL1.Tx <- ndn.MakeInterest("/localhop/B/2")
+	fixture.StepDelay()
+	assert.Equal(1, collectN2.Count())
+	assert.Equal(3, collectL2.Count())
+
+	assert.Equal(0, collectN1.Count())
+	assert.Equal(uint64(1), fixture.SumCounter(func(fwd *fwdp.Fwd) uint64 {
+		return fwd.Counters().NScopeRxDrop
+	}))
+	assert.Equal(uint64(2), fixture.SumCounter(func(fwd *fwdp.Fwd) uint64 {
+		return fwd.Counters().NScopeTxDrop
+	}))
+}
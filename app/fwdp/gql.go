@@ -10,10 +10,13 @@ import (
 	"github.com/usnistgov/ndn-dpdk/container/fib"
 	"github.com/usnistgov/ndn-dpdk/container/pit"
 	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
+	"github.com/usnistgov/ndn-dpdk/core/jsonhelper"
 	"github.com/usnistgov/ndn-dpdk/core/rttest"
 	"github.com/usnistgov/ndn-dpdk/core/runningstat"
 	"github.com/usnistgov/ndn-dpdk/dpdk/ealthread"
 	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndni"
 )
 
 var (
@@ -51,6 +54,11 @@ var (
 	GqlDispatchCountersType    *graphql.Object
 	GqlFwdCountersType         *graphql.Object
 	GqlFibNexthopRttType       *graphql.Object
+	GqlAclActionEnum           *graphql.Enum
+	GqlAclPktTypeEnum          *graphql.Enum
+	GqlAclRuleInput            *graphql.InputObject
+	GqlAclRuleType             *gqlserver.NodeType[*AclRule]
+	GqlAclRuleCountersType     *graphql.Object
 )
 
 func init() {
@@ -130,6 +138,146 @@ func init() {
 		},
 	})
 
+	GqlAclActionEnum = gqlserver.NewStringEnum("FwAclAction", "Packet filter rule action.",
+		AclActAllow, AclActDrop, AclActNack, AclActRateLimit)
+	GqlAclPktTypeEnum = gqlserver.NewStringEnum("FwAclPktType", "Packet type matched by packet filter rule.",
+		AclPktInterest, AclPktData, AclPktNack)
+	aclRuleFieldTypes := gqlserver.FieldTypes{
+		reflect.TypeOf(ndn.Name{}):     ndni.GqlNameType,
+		reflect.TypeOf(AclPktType("")): GqlAclPktTypeEnum,
+		reflect.TypeOf(AclAction("")):  GqlAclActionEnum,
+	}
+	GqlAclRuleInput = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "FwAclRuleInput",
+		Description: "Packet filter rule.",
+		Fields:      gqlserver.BindInputFields[AclRuleConfig](aclRuleFieldTypes),
+	})
+	aclRuleFields := gqlserver.BindFields[AclRule](aclRuleFieldTypes)
+	aclRuleFields["nid"] = &graphql.Field{
+		Description: "Rule identifier.",
+		Type:        gqlserver.NonNullInt,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			rule := p.Source.(*AclRule)
+			return rule.ID(), nil
+		},
+	}
+	aclRuleFields["face"] = &graphql.Field{
+		Description: "Incoming face. Null matches any face.",
+		Type:        iface.GqlFaceType.Object,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			rule := p.Source.(*AclRule)
+			return iface.Get(rule.Face), nil
+		},
+	}
+	GqlAclRuleType = gqlserver.NewNodeType(graphql.ObjectConfig{
+		Name:        "FwAclRule",
+		Description: "Packet filter rule.",
+		Fields:      aclRuleFields,
+	}, gqlserver.NodeConfig[*AclRule]{
+		RetrieveInt: func(id int) *AclRule {
+			if GqlDataPlane == nil {
+				return nil
+			}
+			return GqlDataPlane.acl.Get(id)
+		},
+		Delete: func(rule *AclRule) error {
+			return rule.Close()
+		},
+	})
+
+	gqlserver.AddMutation(&graphql.Field{
+		Name:        "insertFwAclRule",
+		Description: "Insert a packet filter rule in forwarder data plane.",
+		Args: graphql.FieldConfigArgument{
+			"rule": &graphql.ArgumentConfig{
+				Description: "Rule definition.",
+				Type:        graphql.NewNonNull(GqlAclRuleInput),
+			},
+			"face": &graphql.ArgumentConfig{
+				Description: "Incoming face. Default matches any face.",
+				Type:        graphql.ID,
+			},
+			"index": &graphql.ArgumentConfig{
+				Description: "Position in evaluation order. Default appends to the end.",
+				Type:        graphql.Int,
+			},
+		},
+		Type: graphql.NewNonNull(GqlAclRuleType.Object),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			if GqlDataPlane == nil {
+				return nil, errNoGqlDataPlane
+			}
+
+			var cfg AclRuleConfig
+			if e := jsonhelper.Roundtrip(p.Args["rule"], &cfg); e != nil {
+				return nil, e
+			}
+			if faceID, ok := p.Args["face"].(string); ok {
+				face := iface.GqlFaceType.Retrieve(faceID)
+				if face == nil {
+					return nil, errors.New("face not found")
+				}
+				cfg.Face = face.ID()
+			}
+			index := -1
+			if i, ok := p.Args["index"].(int); ok {
+				index = i
+			}
+			return GqlDataPlane.acl.Insert(cfg, index)
+		},
+	})
+
+	GqlAclRuleCountersType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "FwAclRuleCounters",
+		Fields: gqlserver.BindFields[AclRuleCounters](nil),
+	})
+	gqlserver.AddCounters(&gqlserver.CountersConfig{
+		Description:  "Packet filter rule counters, summed across forwarding threads.",
+		Parent:       GqlAclRuleType.Object,
+		Name:         "counters",
+		Subscription: "fwAclRuleCounters",
+		FindArgs: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{
+				Description: "Rule ID.",
+				Type:        gqlserver.NonNullID,
+			},
+		},
+		Find: func(p graphql.ResolveParams) (source any, enders []any, e error) {
+			return GqlAclRuleType.Retrieve(p.Args["id"].(string)), nil, nil
+		},
+		Type: graphql.NewNonNull(GqlAclRuleCountersType),
+		Read: func(p graphql.ResolveParams) (any, error) {
+			rule := p.Source.(*AclRule)
+			return rule.Counters(), nil
+		},
+	})
+
+	gqlserver.AddMutation(&graphql.Field{
+		Name:        "setFwNetworkRegions",
+		Description: "Replace network region table in forwarder data plane.",
+		Args: graphql.FieldConfigArgument{
+			"names": &graphql.ArgumentConfig{
+				Description: "Network region names.",
+				Type:        gqlserver.NewListNonNullBoth(ndni.GqlNameType),
+			},
+		},
+		Type: gqlserver.NewListNonNullBoth(ndni.GqlNameType),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			if GqlDataPlane == nil {
+				return nil, errNoGqlDataPlane
+			}
+
+			names := []ndn.Name{}
+			for _, name := range p.Args["names"].([]any) {
+				names = append(names, name.(ndn.Name))
+			}
+			if e := GqlDataPlane.regions.Set(names); e != nil {
+				return nil, e
+			}
+			return GqlDataPlane.regions.List(), nil
+		},
+	})
+
 	GqlDataPlaneType = graphql.NewObject(graphql.ObjectConfig{
 		Name: "FwDataPlane",
 		Fields: graphql.Fields{
@@ -169,6 +317,22 @@ func init() {
 					return dp.fwds, nil
 				},
 			},
+			"aclRules": &graphql.Field{
+				Description: "Packet filter rules in evaluation order.",
+				Type:        gqlserver.NewListNonNullBoth(GqlAclRuleType.Object),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					dp := p.Source.(*DataPlane)
+					return dp.acl.Rules(), nil
+				},
+			},
+			"networkRegions": &graphql.Field{
+				Description: "Network region names.",
+				Type:        gqlserver.NewListNonNullBoth(ndni.GqlNameType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					dp := p.Source.(*DataPlane)
+					return dp.regions.List(), nil
+				},
+			},
 		},
 	})
 
@@ -234,6 +398,7 @@ func init() {
 		Name: "FwFwdCounters",
 		Fields: gqlserver.BindFields[FwdCounters](gqlserver.FieldTypes{
 			reflect.TypeOf(runningstat.Snapshot{}): runningstat.GqlSnapshotType,
+			reflect.TypeOf(PolicyCounters{}):       GqlPolicyCountersType,
 		}),
 	})
 	fwdCountersConfigTemplate := gqlserver.CountersConfig{
@@ -0,0 +1,93 @@
+package fwdp
+
+/*
+#include "../../csrc/fwdp/fwd.h"
+*/
+import "C"
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/usnistgov/ndn-dpdk/core/urcu"
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"golang.org/x/exp/slices"
+)
+
+// NetRegions is the network region table in the forwarder data plane.
+//
+// When an Interest carries forwarding hints, FwFwd normally performs FIB lookup using the forwarding hints.
+// If any forwarding hint is a prefix of any network region name in this table, the Interest is considered
+// to have reached the producer region, and FwFwd performs FIB lookup using the Interest name instead.
+type NetRegions struct {
+	mutex sync.Mutex
+	fwds  []*Fwd
+	c     *C.FwNetRegion
+	names []ndn.Name
+}
+
+// List returns network region names.
+func (nr *NetRegions) List() []ndn.Name {
+	nr.mutex.Lock()
+	defer nr.mutex.Unlock()
+	return slices.Clone(nr.names)
+}
+
+// Set replaces network region names.
+func (nr *NetRegions) Set(names []ndn.Name) error {
+	if len(names) > NetRegionMax {
+		return errors.New("too many network regions")
+	}
+
+	var c *C.FwNetRegion
+	if len(names) > 0 {
+		c = eal.Zmalloc[C.FwNetRegion]("FwNetRegion", C.sizeof_FwNetRegion, eal.NumaSocket{})
+		nameV := unsafe.Slice((*byte)(unsafe.Pointer(&c.nameV[0])), len(c.nameV))
+		offset := 0
+		for i, name := range names {
+			value, _ := name.MarshalBinary()
+			if offset+len(value) > len(nameV) {
+				eal.Free(c)
+				return errors.New("name buffer is full")
+			}
+			c.nameOffset[i] = C.uint16_t(offset)
+			c.nameL[i] = C.uint16_t(copy(nameV[offset:], value))
+			offset += len(value)
+		}
+		c.nRegions = C.uint32_t(len(names))
+	}
+
+	nr.mutex.Lock()
+	defer nr.mutex.Unlock()
+	var old *C.FwNetRegion
+	for _, fwd := range nr.fwds {
+		old = C.FwNetRegion_Set(&fwd.c.netRegion, c)
+	}
+	nr.c, nr.names = c, slices.Clone(names)
+
+	if old != nil {
+		go func() {
+			urcu.Synchronize()
+			eal.Free(old)
+		}()
+	}
+	return nil
+}
+
+// Close releases the table.
+// Forwarding threads must be stopped.
+func (nr *NetRegions) Close() error {
+	nr.mutex.Lock()
+	defer nr.mutex.Unlock()
+	if nr.c != nil {
+		eal.Free(nr.c)
+		nr.c = nil
+	}
+	nr.names = nil
+	return nil
+}
+
+func newNetRegions(fwds []*Fwd) *NetRegions {
+	return &NetRegions{fwds: fwds}
+}
@@ -0,0 +1,61 @@
+package fwdp
+
+/*
+#include "../../csrc/fwdp/fwd.h"
+*/
+import "C"
+import (
+	"unsafe"
+
+	"github.com/graphql-go/graphql"
+	"github.com/usnistgov/ndn-dpdk/container/fib/fibdef"
+	"github.com/usnistgov/ndn-dpdk/container/pit"
+	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
+	"github.com/usnistgov/ndn-dpdk/core/nnduration"
+	"github.com/zyedidia/generic"
+)
+
+// PolicyConfig contains a per-prefix forwarding policy.
+// A FIB entry selects a policy by its index in Config.Policies.
+type PolicyConfig struct {
+	// Suppress configures Interest retransmission suppression.
+	Suppress pit.SuppressConfig `json:"suppress,omitempty"`
+
+	// MaxLifetime is the maximum InterestLifetime.
+	// Longer InterestLifetime in incoming Interests is reduced to this value.
+	// Default and upper bound is the PIT entry maximum lifetime, 120 seconds.
+	MaxLifetime nnduration.Milliseconds `json:"maxLifetime,omitempty"`
+}
+
+func (pc PolicyConfig) copyToC(c *C.FwPolicy) {
+	pc.Suppress.CopyToC(unsafe.Pointer(&c.suppress))
+	c.maxLifetime = C.PIT_MAX_LIFETIME
+	if pc.MaxLifetime > 0 {
+		c.maxLifetime = C.uint32_t(generic.Min(uint64(pc.MaxLifetime), C.PIT_MAX_LIFETIME))
+	}
+}
+
+// listPolicies returns configured policies, padded with default policy to fibdef.MaxPolicies entries.
+func (cfg Config) listPolicies() (list []PolicyConfig) {
+	list = make([]PolicyConfig, fibdef.MaxPolicies)
+	for i := range list {
+		if i < len(cfg.Policies) {
+			list[i] = cfg.Policies[i]
+		} else {
+			list[i].Suppress = cfg.Suppress
+		}
+	}
+	return list
+}
+
+// PolicyCounters contains per-policy counters in a forwarding thread.
+type PolicyCounters struct {
+	NSuppressed     uint64 `json:"nSuppressed" gqldesc:"Interests suppressed by this policy."`
+	NLifetimeCapped uint64 `json:"nLifetimeCapped" gqldesc:"Interests whose InterestLifetime was capped by this policy."`
+}
+
+// GqlPolicyCountersType is the GraphQL type for PolicyCounters.
+var GqlPolicyCountersType = graphql.NewObject(graphql.ObjectConfig{
+	Name:   "FwPolicyCounters",
+	Fields: gqlserver.BindFields[PolicyCounters](nil),
+})
@@ -28,9 +28,18 @@ struct vxlanhdr
   uint32_t vx_vni;
 } __rte_packed;
 
+struct gtphdr
+{
+  uint8_t flags;
+  uint8_t msg_type;
+  uint16_t length;
+  uint32_t teid;
+} __rte_packed;
+
 enum
 {
   UDPPortVXLAN = 4789,
+  UDPPortGTP = 2152,
 };
 
 #define PacketPtrAs_(ptr, size, ...)                                                               \
@@ -68,9 +68,17 @@ SEC("xdp") int xdp_prog(struct xdp_md* ctx)
   const struct udphdr* udp = PacketPtrAs((const struct udphdr*)pkt);
   pkt += sizeof(*udp);
   loc.udpDst = udp->dest;
-  if (udp->dest != bpf_htons(UDPPortVXLAN)) {
-    loc.udpSrc = udp->source;
-    goto FILTER;
+  switch (udp->dest) {
+    case bpf_htons(UDPPortVXLAN):
+      break;
+    case bpf_htons(UDPPortGTP): {
+      const struct gtphdr* gtp = PacketPtrAs((const struct gtphdr*)pkt);
+      loc.teid = gtp->teid;
+      goto FILTER;
+    }
+    default:
+      loc.udpSrc = udp->source;
+      goto FILTER;
   }
 
   const struct vxlanhdr* vxlan = PacketPtrAs((const struct udphdr*)pkt);
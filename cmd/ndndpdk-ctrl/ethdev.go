@@ -87,6 +87,11 @@ func init() {
 				Usage:       "enable RxFlow with specified number of `queues`",
 				DefaultText: "disable RxFlow",
 			},
+			&cli.UintFlag{
+				Name:        "tx-queues",
+				Usage:       "use specified number of TX `queues`",
+				DefaultText: "1",
+			},
 		},
 		Action: func(c *cli.Context) error {
 			vars := map[string]any{
@@ -112,6 +117,9 @@ func init() {
 			if c.IsSet("rx-flow") {
 				vars["rxFlowQueues"] = c.Uint("rx-flow")
 			}
+			if c.IsSet("tx-queues") {
+				vars["txQueues"] = c.Uint("tx-queues")
+			}
 
 			return clientDoPrint(c.Context, `
 				mutation createEthPort(
@@ -120,6 +128,7 @@ func init() {
 					$netif: String
 					$mtu: Int
 					$rxFlowQueues: Int
+					$txQueues: Int
 				) {
 					createEthPort(
 						driver: $driver
@@ -127,6 +136,7 @@ func init() {
 						netif: $netif
 						mtu: $mtu
 						rxFlowQueues: $rxFlowQueues
+						txQueues: $txQueues
 					) {`+gqlEthDevFields+`}
 				}
 			`, vars, "createEthPort")
@@ -162,3 +172,23 @@ func init() {
 		},
 	})
 }
+
+func init() {
+	defineCommand(&cli.Command{
+		Category: "ethdev",
+		Name:     "watch-eth-link",
+		Usage:    "Watch Ethernet device link status changes",
+		Action: func(c *cli.Context) error {
+			return clientDoPrint(c.Context, `
+				subscription ethLinkEvents {
+					ethLinkEvents {
+						nid
+						name
+						isDown
+						timestamp
+					}
+				}
+			`, nil, "ethLinkEvents")
+		},
+	})
+}
@@ -9,7 +9,11 @@ import (
 	"github.com/usnistgov/ndn-dpdk/ndn/packettransport"
 )
 
-const gqlFaceCounters = "rxFrames rxInterests rxData rxNacks txFrames txInterests txData txNacks"
+const (
+	gqlFaceCounters  = "rxFrames rxInterests rxData rxNacks txFrames txInterests txData txNacks"
+	gqlLatencyStat   = "count min max mean stdev p50 p90 p99"
+	gqlFaceTxLatency = "sojourn {" + gqlLatencyStat + "} residence {" + gqlLatencyStat + "}"
+)
 
 func createFace(c *cli.Context, loc any) error {
 	return clientDoPrint(c.Context, `
@@ -55,7 +59,7 @@ func init() {
 
 func init() {
 	var id string
-	var withCounters bool
+	var withCounters, withLatency bool
 	defineCommand(&cli.Command{
 		Category: "face",
 		Name:     "get-face",
@@ -72,26 +76,81 @@ func init() {
 				Usage:       "show counters",
 				Destination: &withCounters,
 			},
+			&cli.BoolFlag{
+				Name:        "latency",
+				Usage:       "show TX latency statistics",
+				Destination: &withLatency,
+			},
 		},
 		Action: func(c *cli.Context) error {
 			return clientDoPrint(c.Context, `
-				query getFace($id: ID!, $withCounters: Boolean!) {
+				query getFace($id: ID!, $withCounters: Boolean!, $withLatency: Boolean!) {
 					face: node(id: $id) {
 						id
 						... on Face {
 							locator
 							counters @include(if: $withCounters) {`+gqlFaceCounters+`}
+							txLatency @include(if: $withLatency) {`+gqlFaceTxLatency+`}
 						}
 					}
 				}
 			`, map[string]any{
 				"id":           id,
 				"withCounters": withCounters,
+				"withLatency":  withLatency,
 			}, "face")
 		},
 	})
 }
 
+func init() {
+	var id string
+	defineCommand(&cli.Command{
+		Category: "face",
+		Name:     "reset-face-latency",
+		Usage:    "Reset TX latency statistics of a face",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "id",
+				Usage:       "face `ID`",
+				Destination: &id,
+				Required:    true,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return clientDoPrint(c.Context, `
+				mutation resetFaceTxLatency($id: ID!) {
+					resetFaceTxLatency(id: $id) {
+						id
+					}
+				}
+			`, map[string]any{
+				"id": id,
+			}, "resetFaceTxLatency")
+		},
+	})
+}
+
+func init() {
+	defineCommand(&cli.Command{
+		Category: "face",
+		Name:     "watch-face-events",
+		Usage:    "Watch face creation, state changes, and closing",
+		Action: func(c *cli.Context) error {
+			return clientDoPrint(c.Context, `
+				subscription faceEvents {
+					faceEvents {
+						kind
+						nid
+						locator
+						timestamp
+					}
+				}
+			`, nil, "faceEvents")
+		},
+	})
+}
+
 func init() {
 	defineStdinJSONCommand(stdinJSONCommand{
 		Category:   "face",
@@ -107,20 +166,26 @@ func init() {
 
 func init() {
 	var loc struct {
-		Scheme      string        `json:"scheme"`
-		Port        string        `json:"port,omitempty"`
-		MTU         int           `json:"mtu,omitempty"`
-		NRxQueues   int           `json:"nRxQueues,omitempty"`
-		Local       macaddr.Flag  `json:"local"`
-		Remote      macaddr.Flag  `json:"remote"`
-		VLAN        int           `json:"vlan,omitempty"`
-		LocalIP     *netip.Addr   `json:"localIP,omitempty"`
-		RemoteIP    *netip.Addr   `json:"remoteIP,omitempty"`
-		LocalUDP    *int          `json:"localUDP,omitempty"`
-		RemoteUDP   *int          `json:"remoteUDP,omitempty"`
-		VXLAN       int           `json:"vxlan,omitempty"`
-		InnerLocal  *macaddr.Flag `json:"innerLocal,omitempty"`
-		InnerRemote *macaddr.Flag `json:"innerRemote,omitempty"`
+		Scheme        string        `json:"scheme"`
+		Port          string        `json:"port,omitempty"`
+		MTU           int           `json:"mtu,omitempty"`
+		NRxQueues     int           `json:"nRxQueues,omitempty"`
+		Local         macaddr.Flag  `json:"local"`
+		Remote        macaddr.Flag  `json:"remote"`
+		VLAN          int           `json:"vlan,omitempty"`
+		LocalIP       *netip.Addr   `json:"localIP,omitempty"`
+		RemoteIP      *netip.Addr   `json:"remoteIP,omitempty"`
+		LocalUDP      *int          `json:"localUDP,omitempty"`
+		RemoteUDP     *int          `json:"remoteUDP,omitempty"`
+		VXLAN         int           `json:"vxlan,omitempty"`
+		InnerLocal    *macaddr.Flag `json:"innerLocal,omitempty"`
+		InnerRemote   *macaddr.Flag `json:"innerRemote,omitempty"`
+		UlTEID        int           `json:"ulTEID,omitempty"`
+		UlQFI         int           `json:"ulQFI,omitempty"`
+		DlTEID        int           `json:"dlTEID,omitempty"`
+		DlQFI         int           `json:"dlQFI,omitempty"`
+		InnerLocalIP  *netip.Addr   `json:"innerLocalIP,omitempty"`
+		InnerRemoteIP *netip.Addr   `json:"innerRemoteIP,omitempty"`
 	}
 	loc.Remote.HardwareAddr = packettransport.MulticastAddressNDN
 
@@ -213,26 +278,30 @@ func init() {
 		ip, _ := netip.AddrFromSlice(addr.IP)
 		return &ip, nil
 	}
-	var innerLocal, innerRemote macaddr.Flag
-	define("create-vxlan-face", "Create a VXLAN face", "vxlan", true,
-		&cli.StringFlag{
-			Name:     "ip-local",
-			Usage:    "local IP `host`",
-			Required: true,
-			Action: func(c *cli.Context, s string) (e error) {
-				loc.LocalIP, e = resolveIPFlag(s)
-				return
+	makeIPFlags := func() []cli.Flag {
+		return []cli.Flag{
+			&cli.StringFlag{
+				Name:     "ip-local",
+				Usage:    "local IP `host`",
+				Required: true,
+				Action: func(c *cli.Context, s string) (e error) {
+					loc.LocalIP, e = resolveIPFlag(s)
+					return
+				},
 			},
-		},
-		&cli.StringFlag{
-			Name:     "ip-remote",
-			Usage:    "remote IP `host`",
-			Required: true,
-			Action: func(c *cli.Context, s string) (e error) {
-				loc.RemoteIP, e = resolveIPFlag(s)
-				return
+			&cli.StringFlag{
+				Name:     "ip-remote",
+				Usage:    "remote IP `host`",
+				Required: true,
+				Action: func(c *cli.Context, s string) (e error) {
+					loc.RemoteIP, e = resolveIPFlag(s)
+					return
+				},
 			},
-		},
+		}
+	}
+	var innerLocal, innerRemote macaddr.Flag
+	define("create-vxlan-face", "Create a VXLAN face", "vxlan", true, append(makeIPFlags(),
 		&cli.IntFlag{
 			Name:        "vxlan",
 			Usage:       "`VXLAN` virtual network identifier",
@@ -259,7 +328,48 @@ func init() {
 				return nil
 			},
 		},
-	)
+	)...)
+
+	define("create-gtp-face", "Create a GTP-U face", "gtp", true, append(makeIPFlags(),
+		&cli.IntFlag{
+			Name:        "ul-teid",
+			Usage:       "uplink tunnel endpoint identifier `TEID`",
+			Destination: &loc.UlTEID,
+			Required:    true,
+		},
+		&cli.IntFlag{
+			Name:        "ul-qfi",
+			Usage:       "uplink QoS flow identifier `QFI`",
+			Destination: &loc.UlQFI,
+		},
+		&cli.IntFlag{
+			Name:        "dl-teid",
+			Usage:       "downlink tunnel endpoint identifier `TEID`",
+			Destination: &loc.DlTEID,
+			Required:    true,
+		},
+		&cli.IntFlag{
+			Name:        "dl-qfi",
+			Usage:       "downlink QoS flow identifier `QFI`",
+			Destination: &loc.DlQFI,
+		},
+		&cli.StringFlag{
+			Name:  "inner-ip-local",
+			Usage: "inner local IPv4 `address`",
+			Action: func(c *cli.Context, s string) (e error) {
+				loc.InnerLocalIP, e = resolveIPFlag(s)
+				return
+			},
+		},
+		&cli.StringFlag{
+			Name:  "inner-ip-remote",
+			Usage: "inner remote IPv4 `address`",
+			Action: func(c *cli.Context, s string) (e error) {
+				loc.InnerRemoteIP, e = resolveIPFlag(s)
+				return
+			},
+		},
+	)...)
 }
 
 func init() {
@@ -27,6 +27,7 @@ type nfdReg struct {
 	ServedCerts   []ndn.Data
 	DefaultOrigin int
 	DefaultCost   int
+	AnnExpiration time.Duration
 	Commands      []nfdmgmt.ControlCommand
 	CommandsDesc  []string
 	Interval      time.Duration
@@ -126,6 +127,23 @@ func (cmd *nfdReg) AddUnregisterCommand(p string) (e error) {
 	return nil
 }
 
+func (cmd *nfdReg) AddAnnounceCommand(p string) error {
+	if cmd.Client.Signer == nil {
+		return errors.New("prefix announcement requires a signer")
+	}
+
+	pa, e := nfdmgmt.MakePrefixAnn(cmd.Client.Signer, nfdmgmt.MakePrefixAnnOptions{
+		Prefix:     ndn.ParseName(p),
+		Expiration: cmd.AnnExpiration,
+	})
+	if e != nil {
+		return e
+	}
+
+	cmd.addCommand(nfdmgmt.RibAnnounceCommand{PrefixAnn: *pa})
+	return nil
+}
+
 func (cmd *nfdReg) addCommand(c nfdmgmt.ControlCommand) {
 	cmd.Commands = append(cmd.Commands, c)
 
@@ -159,7 +177,7 @@ func (cmd *nfdReg) SendCommands(ctx context.Context) {
 
 func init() {
 	var commandPrefixURI, safeBagFile, safeBagPassphrase string
-	var serveCertz, registerz, unregisterz flagz.Flagz
+	var serveCertz, registerz, unregisterz, announcez flagz.Flagz
 	var cmd nfdReg
 
 	defineCommand(&cli.Command{
@@ -209,6 +227,17 @@ func init() {
 				Usage: "Unregister prefix(es).",
 				Value: &unregisterz,
 			},
+			&cli.GenericFlag{
+				Name:  "announce",
+				Usage: "Announce prefix(es) with signed prefix announcement.",
+				Value: &announcez,
+			},
+			&cli.DurationFlag{
+				Name:        "announce-expires",
+				Usage:       "Prefix announcement expiration period.",
+				Value:       1 * time.Hour,
+				Destination: &cmd.AnnExpiration,
+			},
 			&cli.DurationFlag{
 				Name:        "interval",
 				Usage:       "Interval between commands.",
@@ -248,6 +277,11 @@ func init() {
 					return fmt.Errorf("register command %d: %w", i, e)
 				}
 			}
+			for i, p := range announcez.Array() {
+				if e := cmd.AddAnnounceCommand(p); e != nil {
+					return fmt.Errorf("announce command %d: %w", i, e)
+				}
+			}
 
 			return openUplink(c)
 		},
@@ -15,6 +15,7 @@ type EntryBody struct {
 	Nexthops []iface.ID     `json:"nexthops"`
 	Strategy int            `json:"strategy"`
 	Params   map[string]any `json:"params"`
+	Policy   int            `json:"policy,omitempty"`
 }
 
 // HasNextHop determines whether a nexthop face exists.
@@ -24,7 +25,7 @@ func (entry EntryBody) HasNextHop(id iface.ID) bool {
 
 // EntryBodyEquals determines whether two EntryBody records have the same values.
 func EntryBodyEquals(lhs, rhs EntryBody) bool {
-	if lhs.Strategy != rhs.Strategy || len(lhs.Nexthops) != len(rhs.Nexthops) {
+	if lhs.Strategy != rhs.Strategy || lhs.Policy != rhs.Policy || len(lhs.Nexthops) != len(rhs.Nexthops) {
 		return false
 	}
 	for i, n := range lhs.Nexthops {
@@ -55,6 +56,9 @@ func (entry Entry) Validate() error {
 	if entry.Strategy == 0 {
 		return errors.New("missing strategy")
 	}
+	if entry.Policy < 0 || entry.Policy >= MaxPolicies {
+		return fmt.Errorf("policy must be between 0 and %d", MaxPolicies-1)
+	}
 	return nil
 }
 
@@ -13,5 +13,8 @@ const (
 	// ScratchSize is the size of strategy scratch area.
 	ScratchSize = 96
 
+	// MaxPolicies is the maximum number of forwarding policies referenced by FIB entries.
+	MaxPolicies = 8
+
 	_ = "enumgen::Fib"
 )
@@ -66,6 +66,7 @@ func (entry *Entry) Read() (de fibdef.Entry) {
 	}
 
 	de.Strategy = int((*entry.ptrStrategy()).id)
+	de.Policy = int(entry.policy)
 	return
 }
 
@@ -118,6 +119,8 @@ func (entry *Entry) assignReal(u *fibdef.RealUpdate, sgGlobals []unsafe.Pointer)
 		entry.nexthops[i] = C.FaceID(nh)
 	}
 
+	entry.policy = C.uint8_t(u.Policy)
+
 	sc := strategycode.Get(u.Strategy)
 	*entry.ptrStrategy() = (*C.StrategyCode)(sc.Ptr())
 
@@ -66,6 +66,14 @@ func init() {
 					return strategycode.Get(entry.Strategy), nil
 				},
 			},
+			"policy": &graphql.Field{
+				Description: "Forwarding policy index.",
+				Type:        gqlserver.NonNullInt,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					entry := p.Source.(Entry)
+					return entry.Policy, nil
+				},
+			},
 			"counters": &graphql.Field{
 				Description: "Entry counters.",
 				Type:        graphql.NewNonNull(GqlEntryCountersType),
@@ -190,6 +198,10 @@ func init() {
 				Description: "Forwarding strategy parameters.",
 				Type:        gqlserver.JSON,
 			},
+			"policy": &graphql.ArgumentConfig{
+				Description: "Forwarding policy index.",
+				Type:        graphql.Int,
+			},
 		},
 		Type: graphql.NewNonNull(GqlEntryType.Object),
 		Resolve: func(p graphql.ResolveParams) (any, error) {
@@ -220,6 +232,10 @@ func init() {
 				entry.Params = params
 			}
 
+			if policy, ok := p.Args["policy"].(int); ok {
+				entry.Policy = policy
+			}
+
 			if e := GqlFib.Insert(entry); e != nil {
 				return nil, e
 			}
@@ -70,7 +70,7 @@ func (entry *Entry) DnRecords() (list []DnRecord) {
 
 // InsertDnRecord inserts new downstream record, or update existing downstream record.
 func (entry *Entry) InsertDnRecord(interest *ndni.Packet) *DnRecord {
-	dnC := C.PitEntry_InsertDn(entry.ptr(), entry.pitPtr(), (*C.Packet)(interest.Ptr()))
+	dnC := C.PitEntry_InsertDn(entry.ptr(), entry.pitPtr(), (*C.Packet)(interest.Ptr()), C.PIT_MAX_LIFETIME)
 	return &DnRecord{dnC, entry}
 }
 
@@ -23,6 +23,36 @@ func PublishChan(f func(updates chan<- any)) (any, error) {
 	return updates, nil
 }
 
+// PublishEvents publishes events in reply to GraphQL subscription.
+//
+// register is a callback function that registers an event listener and returns a cancel function.
+// The listener may be invoked on any goroutine; it does not block, but drops events if the client is too slow.
+func PublishEvents[T any](p graphql.ResolveParams, register func(cb func(T)) (cancel func())) (any, error) {
+	return PublishChan(func(updates chan<- any) {
+		events := make(chan T, 64)
+		cancel := register(func(evt T) {
+			select {
+			case events <- evt:
+			default:
+			}
+		})
+		defer cancel()
+
+		for {
+			select {
+			case evt := <-events:
+				select {
+				case updates <- evt:
+				case <-p.Context.Done():
+					return
+				}
+			case <-p.Context.Done():
+				return
+			}
+		}
+	})
+}
+
 var (
 	subArgInterval = graphql.FieldConfigArgument{
 		"interval": &graphql.ArgumentConfig{
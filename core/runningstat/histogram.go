@@ -0,0 +1,118 @@
+package runningstat
+
+/*
+#include "../../csrc/core/running-stat.h"
+*/
+import "C"
+import (
+	"math"
+
+	"github.com/graphql-go/graphql"
+	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
+)
+
+const (
+	histSubBits = C.RunningStatH_SubBits
+	histSubMask = 1<<histSubBits - 1
+)
+
+// Histogram contains sample counts in logarithmic buckets, as collected by HistStat.
+type Histogram []uint64
+
+// bucketRange returns the minimum and maximum input values that fall into a bucket.
+func bucketRange(b int) (lo, hi uint64) {
+	if b <= histSubMask {
+		return uint64(b), uint64(b)
+	}
+	shift := b>>histSubBits - 1
+	lo = uint64(1<<histSubBits|b&histSubMask) << shift
+	return lo, lo + 1<<shift - 1
+}
+
+// Count returns total number of samples.
+func (h Histogram) Count() (n uint64) {
+	for _, c := range h {
+		n += c
+	}
+	return n
+}
+
+// Quantile estimates the q-quantile, where q is between 0 and 1.
+// The estimate is the upper bound of the bucket that contains the quantile.
+// Returns NaN if the histogram is empty.
+func (h Histogram) Quantile(q float64) float64 {
+	total := h.Count()
+	if total == 0 {
+		return math.NaN()
+	}
+
+	rank := uint64(math.Ceil(q * float64(total)))
+	var cum uint64
+	for b, c := range h {
+		cum += c
+		if c > 0 && cum >= rank {
+			_, hi := bucketRange(b)
+			return float64(hi)
+		}
+	}
+	_, hi := bucketRange(len(h) - 1)
+	return float64(hi)
+}
+
+// Percentiles contains estimated percentiles.
+type Percentiles struct {
+	P50  float64 `json:"p50" gqldesc:"Estimated 50th percentile. Valid if count>0."`
+	P90  float64 `json:"p90" gqldesc:"Estimated 90th percentile. Valid if count>0."`
+	P99  float64 `json:"p99" gqldesc:"Estimated 99th percentile. Valid if count>0."`
+	P999 float64 `json:"p999" gqldesc:"Estimated 99.9th percentile. Valid if count>0."`
+}
+
+// Percentiles estimates common percentiles.
+func (h Histogram) Percentiles() (p Percentiles) {
+	if h.Count() == 0 {
+		return
+	}
+	return Percentiles{
+		P50:  h.Quantile(0.50),
+		P90:  h.Quantile(0.90),
+		P99:  h.Quantile(0.99),
+		P999: h.Quantile(0.999),
+	}
+}
+
+// Scale multiplies every number by a ratio.
+func (p Percentiles) Scale(ratio float64) Percentiles {
+	p.P50 *= ratio
+	p.P90 *= ratio
+	p.P99 *= ratio
+	p.P999 *= ratio
+	return p
+}
+
+// HistSnapshot contains a snapshot of HistStat reading.
+type HistSnapshot struct {
+	Snapshot
+	Percentiles
+}
+
+// Scale multiplies every number by a ratio.
+func (s HistSnapshot) Scale(ratio float64) HistSnapshot {
+	return HistSnapshot{
+		Snapshot:    s.Snapshot.Scale(ratio),
+		Percentiles: s.Percentiles.Scale(ratio),
+	}
+}
+
+// ReadSnapshot returns current counters and estimated percentiles as HistSnapshot.
+func (s *HistStat) ReadSnapshot() HistSnapshot {
+	return HistSnapshot{
+		Snapshot:    s.Read(),
+		Percentiles: s.ReadHistogram().Percentiles(),
+	}
+}
+
+// GqlHistSnapshotType is the GraphQL type for HistSnapshot.
+var GqlHistSnapshotType = graphql.NewObject(graphql.ObjectConfig{
+	Name:   "RunningStatHistSnapshot",
+	Fields: gqlserver.BindFields[HistSnapshot](nil),
+})
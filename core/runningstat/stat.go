@@ -72,3 +72,43 @@ func (s *IntStat) Read() Snapshot {
 func IntFromPtr(ptr unsafe.Pointer) (s *IntStat) {
 	return (*IntStat)(ptr)
 }
+
+// HistStat collects statistics like IntStat, and additionally maintains a histogram for estimating quantiles.
+type HistStat C.RunningStatH
+
+func (s *HistStat) ptr() *C.RunningStatH {
+	return (*C.RunningStatH)(s)
+}
+
+// Init initializes the instance and clears existing data.
+// sampleInterval: how often to collect sample, will be adjusted to nearest power of two and truncated between 1 and 2^30.
+func (s *HistStat) Init(sampleInterval int) {
+	(*IntStat)(unsafe.Pointer(&s.si)).Init(sampleInterval)
+	for i := range s.hist {
+		s.hist[i] = 0
+	}
+}
+
+// Push adds an input.
+func (s *HistStat) Push(x uint64) {
+	C.RunningStatH_Push(s.ptr(), C.uint64_t(x))
+}
+
+// Read returns current counters as Snapshot.
+func (s *HistStat) Read() Snapshot {
+	return (*IntStat)(unsafe.Pointer(&s.si)).Read()
+}
+
+// ReadHistogram returns a copy of current histogram.
+func (s *HistStat) ReadHistogram() (h Histogram) {
+	h = make(Histogram, len(s.hist))
+	for i, n := range s.hist {
+		h[i] = uint64(n)
+	}
+	return h
+}
+
+// HistFromPtr converts *C.RunningStatH to HistStat.
+func HistFromPtr(ptr unsafe.Pointer) (s *HistStat) {
+	return (*HistStat)(ptr)
+}
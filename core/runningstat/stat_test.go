@@ -112,3 +112,44 @@ func TestIntStat(t *testing.T) {
 	assert.InDelta(mean*10, o.Mean, 1.0)
 	assert.InDelta(stdev*10, o.Stdev, 1.0)
 }
+
+func TestHistStat(t *testing.T) {
+	assert, _ := makeAR(t)
+
+	var s runningstat.HistStat
+	s.Init(1)
+
+	o := s.ReadSnapshot()
+	assert.EqualValues(0, o.Count)
+	assert.Nil(o.Min)
+	assert.Zero(o.P50)
+
+	for x := uint64(1); x <= 1000; x++ {
+		s.Push(x)
+	}
+
+	o = s.ReadSnapshot()
+	assert.EqualValues(1000, o.Count)
+	if assert.NotNil(o.Min) {
+		assert.EqualValues(1, *o.Min)
+	}
+	if assert.NotNil(o.Max) {
+		assert.EqualValues(1000, *o.Max)
+	}
+	assert.InDelta(500.5, o.Mean, 0.1)
+
+	// each estimate is the upper bound of a bucket, which is at most 25% above the true value
+	assert.GreaterOrEqual(o.P50, 500.0)
+	assert.LessOrEqual(o.P50, 625.0)
+	assert.GreaterOrEqual(o.P90, 900.0)
+	assert.LessOrEqual(o.P90, 1125.0)
+	assert.GreaterOrEqual(o.P99, 990.0)
+	assert.LessOrEqual(o.P99, 1240.0)
+
+	o = o.Scale(2)
+	assert.InDelta(1001.0, o.Mean, 0.1)
+	assert.GreaterOrEqual(o.P50, 1000.0)
+
+	s.Init(1)
+	assert.EqualValues(0, s.ReadHistogram().Count())
+}
@@ -46,14 +46,83 @@ typedef struct RunningStatI
   uint64_t max;
 } RunningStatI;
 
-/** @brief Add a sample. */
-__attribute__((nonnull)) static inline void
-RunningStatI_Push(RunningStatI* s, uint64_t x)
+__attribute__((nonnull)) static __rte_always_inline bool
+RunningStatI_Push_(RunningStatI* s, uint64_t x)
 {
   if (RunningStat_Push_(&s->s, x)) {
     s->min = RTE_MIN(s->min, x);
     s->max = RTE_MAX(s->max, x);
+    return true;
+  }
+  return false;
+}
+
+/** @brief Add a sample. */
+__attribute__((nonnull)) static inline void
+RunningStatI_Push(RunningStatI* s, uint64_t x)
+{
+  RunningStatI_Push_(s, x);
+}
+
+/** @brief Clear collected data, retaining sample interval. */
+__attribute__((nonnull)) static inline void
+RunningStatI_Clear(RunningStatI* s)
+{
+  *s = (RunningStatI){
+    .s = { .mask = s->s.mask },
+    .min = UINT64_MAX,
+  };
+}
+
+enum
+{
+  /** @brief log2 of number of sub-buckets per power of two in RunningStatH histogram. */
+  RunningStatH_SubBits = 2,
+  /** @brief Number of buckets in RunningStatH histogram. */
+  RunningStatH_Buckets = 64 << RunningStatH_SubBits,
+};
+
+/**
+ * @brief Facility to compute mean and variance, with integer min and max, and a histogram
+ *        for estimating quantiles.
+ *
+ * The histogram has logarithmic buckets, where each power of two is divided into
+ * 2^RunningStatH_SubBits equal sub-buckets.
+ */
+typedef struct RunningStatH
+{
+  RunningStatI si;
+  uint64_t hist[RunningStatH_Buckets];
+} RunningStatH;
+
+/** @brief Determine histogram bucket of an input. */
+static __rte_always_inline unsigned
+RunningStatH_BucketOf(uint64_t x)
+{
+  if (x < (1 << RunningStatH_SubBits)) {
+    return x;
+  }
+  unsigned msb = 63 - __builtin_clzll(x);
+  unsigned shift = msb - RunningStatH_SubBits;
+  unsigned sub = (x >> shift) & ((1 << RunningStatH_SubBits) - 1);
+  return ((shift + 1) << RunningStatH_SubBits) | sub;
+}
+
+/** @brief Add a sample. */
+__attribute__((nonnull)) static inline void
+RunningStatH_Push(RunningStatH* s, uint64_t x)
+{
+  if (RunningStatI_Push_(&s->si, x)) {
+    ++s->hist[RunningStatH_BucketOf(x)];
   }
 }
 
+/** @brief Clear collected data, retaining sample interval. */
+__attribute__((nonnull)) static inline void
+RunningStatH_Clear(RunningStatH* s)
+{
+  RunningStatI_Clear(&s->si);
+  memset(s->hist, 0, sizeof(s->hist));
+}
+
 #endif // NDNDPDK_CORE_RUNNING_STAT_H
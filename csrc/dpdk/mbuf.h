@@ -9,6 +9,7 @@
 #include <rte_ring.h>
 
 extern int Mbuf_Timestamp_DynFieldOffset_;
+extern int Mbuf_EnqueueTime_DynFieldOffset_;
 
 /** @brief Register mbuf dynfields. */
 bool
@@ -28,6 +29,20 @@ Mbuf_SetTimestamp(struct rte_mbuf* m, TscTime timestamp)
   *RTE_MBUF_DYNFIELD(m, Mbuf_Timestamp_DynFieldOffset_, TscTime*) = timestamp;
 }
 
+/** @brief Retrieve the time when mbuf was enqueued for transmission. */
+__attribute__((nonnull)) static inline TscTime
+Mbuf_GetEnqueueTime(struct rte_mbuf* m)
+{
+  return *RTE_MBUF_DYNFIELD(m, Mbuf_EnqueueTime_DynFieldOffset_, TscTime*);
+}
+
+/** @brief Assign the time when mbuf was enqueued for transmission. */
+__attribute__((nonnull)) static inline void
+Mbuf_SetEnqueueTime(struct rte_mbuf* m, TscTime t)
+{
+  *RTE_MBUF_DYNFIELD(m, Mbuf_EnqueueTime_DynFieldOffset_, TscTime*) = t;
+}
+
 /**
  * @brief Copy @c m[off:off+len] into @p dst .
  * @param dst must have @p len room.
@@ -89,19 +89,22 @@ EthFace_SetupFlow(EthFacePriv* priv, const uint16_t queues[], int nQueues, const
 }
 
 __attribute__((nonnull)) void
-EthFace_SetupRxMemif(EthFacePriv* priv, const EthLocator* loc)
+EthFace_SetupRxMemif(EthFacePriv* priv, const EthLocator* loc, int nQueues)
 {
-  priv->rxf[0] = (const EthRxFlow){
-    .base = {
-      .rxBurst = EthRxFlow_RxBurst_Unchecked,
-      .rxThread = 0,
-    },
-    .faceID = priv->faceID,
-    .port = priv->port,
-    .queue = 0,
-    .hdrLen = 0,
-    .rxMatch = NULL,
-  };
+  NDNDPDK_ASSERT(nQueues > 0 && nQueues <= (int)RTE_DIM(priv->rxf));
+  for (int i = 0; i < nQueues; ++i) {
+    priv->rxf[i] = (const EthRxFlow){
+      .base = {
+        .rxBurst = EthRxFlow_RxBurst_Unchecked,
+        .rxThread = i,
+      },
+      .faceID = priv->faceID,
+      .port = priv->port,
+      .queue = i,
+      .hdrLen = 0,
+      .rxMatch = NULL,
+    };
+  }
 }
 
 uint16_t
@@ -113,7 +116,7 @@ EthFace_TxBurst(Face* face, struct rte_mbuf** pkts, uint16_t nPkts)
     NDNDPDK_ASSERT(!face->txAlign.linearize || rte_pktmbuf_is_contiguous(m));
     EthTxHdr_Prepend(&priv->txHdr, m, i == 0);
   }
-  return rte_eth_tx_burst(priv->port, 0, pkts, nPkts);
+  return rte_eth_tx_burst(priv->port, priv->txQueue, pkts, nPkts);
 }
 
 STATIC_ASSERT_FUNC_TYPE(Face_TxBurstFunc, EthFace_TxBurst);
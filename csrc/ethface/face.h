@@ -24,6 +24,7 @@ typedef struct EthFacePriv
   EthTxHdr txHdr;
   FaceID faceID;
   uint16_t port;
+  uint16_t txQueue;
 
   struct cds_hlist_node rxtNode;
   EthRxMatch rxMatch;
@@ -34,9 +35,12 @@ __attribute__((nonnull)) struct rte_flow*
 EthFace_SetupFlow(EthFacePriv* priv, const uint16_t queues[], int nQueues, const EthLocator* loc,
                   bool isolated, struct rte_flow_error* error);
 
-/** @brief Setup RX for memif. */
+/**
+ * @brief Setup RX for memif.
+ * @param nQueues number of RX queues, each becomes an RxGroup.
+ */
 __attribute__((nonnull)) void
-EthFace_SetupRxMemif(EthFacePriv* priv, const EthLocator* loc);
+EthFace_SetupRxMemif(EthFacePriv* priv, const EthLocator* loc, int nQueues);
 
 __attribute__((nonnull)) uint16_t
 EthFace_TxBurst(Face* face, struct rte_mbuf** pkts, uint16_t nPkts);
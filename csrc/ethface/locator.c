@@ -4,6 +4,12 @@
 #define IP_HOPLIMIT_VALUE 64
 #define VXLAN_SRCPORT_BASE 0xC000
 #define VXLAN_SRCPORT_MASK 0x3FFF
+#define GTP_FLAGS_E 0x34      // version 1, protocol type GTP, E flag
+#define GTP_FLAGS_MASK 0xF4   // ignore S and PN flags
+#define GTP_MSGTYPE_GPDU 0xFF // G-PDU
+#define GTP_EXT_PSC 0x85      // PDU session container
+#define GTP_PDUTYPE_DL 0x00   // DL PDU SESSION INFORMATION, in upper 4 bits
+#define GTP_PDUTYPE_UL 0x10   // UL PDU SESSION INFORMATION, in upper 4 bits
 static const uint8_t V4_IN_V6_PREFIX[] = { 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
                                            0x00, 0x00, 0x00, 0x00, 0xFF, 0xFF };
 static RTE_DEFINE_PER_LCORE(uint16_t, txVxlanSrcPort);
@@ -19,6 +25,8 @@ EthLocator_Classify(const EthLocator* loc)
   c.udp = loc->remoteUDP != 0;
   c.v4 = memcmp(loc->remoteIP, V4_IN_V6_PREFIX, sizeof(V4_IN_V6_PREFIX)) == 0;
   c.vxlan = !rte_is_zero_ether_addr(&loc->innerRemote);
+  c.gtp = loc->dlTEID != 0;
+  c.gtpInner = c.gtp && memcmp(loc->innerRemoteIP, V4_IN_V6_PREFIX, sizeof(V4_IN_V6_PREFIX)) == 0;
   c.etherType = !c.udp ? EtherTypeNDN : c.v4 ? RTE_ETHER_TYPE_IPV4 : RTE_ETHER_TYPE_IPV6;
   return c;
 }
@@ -58,18 +66,23 @@ EthLocator_CanCoexist(const EthLocator* a, const EthLocator* b)
     // different IP addresses can coexist
     return true;
   }
-  if (!ac.vxlan && !bc.vxlan) {
+  bool aTunnel = ac.vxlan || ac.gtp, bTunnel = bc.vxlan || bc.gtp;
+  if (!aTunnel && !bTunnel) {
     // UDP faces can coexist if either port number differs
     return a->localUDP != b->localUDP || a->remoteUDP != b->remoteUDP;
   }
   if (a->localUDP != b->localUDP && a->remoteUDP != b->remoteUDP) {
-    // UDP face and VXLAN face -or- two VXLAN faces can coexist if both port numbers differ
+    // UDP face and tunnel face -or- two tunnel faces can coexist if both port numbers differ
     return true;
   }
-  if (ac.vxlan != bc.vxlan) {
-    // UDP face and VXLAN face with same port numbers conflict
+  if (ac.vxlan != bc.vxlan || ac.gtp != bc.gtp) {
+    // UDP face and tunnel face -or- VXLAN face and GTP-U face with same port numbers conflict
     return false;
   }
+  if (ac.gtp) {
+    // GTP-U faces can coexist if uplink TEID differs
+    return a->ulTEID != b->ulTEID;
+  }
   // VXLAN faces can coexist if VNI or inner MAC address differ
   return a->vxlan != b->vxlan || !rte_is_same_ether_addr(&a->innerLocal, &b->innerLocal) ||
          !rte_is_same_ether_addr(&a->innerRemote, &b->innerRemote);
@@ -149,6 +162,20 @@ PutVxlanHdr(uint8_t* buffer, uint32_t vni)
   return sizeof(*vxlan);
 }
 
+__attribute__((nonnull)) static uint8_t
+PutGtpHdr(uint8_t* buffer, uint32_t teid, uint8_t pduType, uint8_t qfi)
+{
+  EthGtpHdr* gtp = (EthGtpHdr*)buffer;
+  gtp->flags = GTP_FLAGS_E;
+  gtp->msgType = GTP_MSGTYPE_GPDU;
+  gtp->teid = rte_cpu_to_be_32(teid);
+  gtp->nextExt = GTP_EXT_PSC;
+  gtp->pscLength = 1;
+  gtp->pscPduType = pduType;
+  gtp->pscQfi = qfi & 0x3F;
+  return sizeof(*gtp);
+}
+
 __attribute__((nonnull)) static inline bool
 MatchAlways(const EthRxMatch* match, const struct rte_mbuf* m)
 {
@@ -210,6 +237,35 @@ MatchVxlan(const EthRxMatch* match, const struct rte_mbuf* m)
          memcmp(innerEthM, innerEthT, RTE_ETHER_HDR_LEN) == 0;
 }
 
+__attribute__((nonnull)) static inline bool
+MatchGtp(const EthRxMatch* match, const struct rte_mbuf* m)
+{
+  // exact match on UDP destination port, GTP-U header except length and optional fields and QFI,
+  // inner IPv4 addresses and UDP port numbers if present
+  const struct rte_udp_hdr* udpM =
+    rte_pktmbuf_mtod_offset(m, const struct rte_udp_hdr*, match->udpOff);
+  const EthGtpHdr* gtpM = RTE_PTR_ADD(udpM, sizeof(*udpM));
+  const struct rte_udp_hdr* udpT = RTE_PTR_ADD(match->buf, match->udpOff);
+  const EthGtpHdr* gtpT = RTE_PTR_ADD(udpT, sizeof(*udpT));
+  if (!(MatchUdp(match, m) && udpM->dst_port == udpT->dst_port &&
+        (gtpM->flags & GTP_FLAGS_MASK) == gtpT->flags && gtpM->msgType == gtpT->msgType &&
+        gtpM->teid == gtpT->teid && gtpM->nextExt == gtpT->nextExt &&
+        gtpM->pscLength == gtpT->pscLength && (gtpM->pscPduType & 0xF0) == gtpT->pscPduType &&
+        gtpM->pscNextExt == gtpT->pscNextExt)) {
+    return false;
+  }
+
+  const struct rte_ipv4_hdr* ipT = RTE_PTR_ADD(gtpT, sizeof(*gtpT));
+  if ((const uint8_t*)ipT == RTE_PTR_ADD(match->buf, match->len)) {
+    return true;
+  }
+  const struct rte_ipv4_hdr* ipM = RTE_PTR_ADD(gtpM, sizeof(*gtpM));
+  return ipM->version_ihl == ipT->version_ihl && ipM->next_proto_id == ipT->next_proto_id &&
+         memcmp(&ipM->src_addr, &ipT->src_addr,
+                sizeof(struct rte_ipv4_hdr) - offsetof(struct rte_ipv4_hdr, src_addr) +
+                  offsetof(struct rte_udp_hdr, dgram_len)) == 0;
+}
+
 void
 EthRxMatch_Prepare(EthRxMatch* match, const EthLocator* loc)
 {
@@ -237,11 +293,21 @@ EthRxMatch_Prepare(EthRxMatch* match, const EthLocator* loc)
   match->f = MatchUdp;
   match->l3matchOff = match->udpOff - l3addrsLen;
   match->l3matchLen = l3addrsLen + offsetof(struct rte_udp_hdr, dgram_len);
-  if (!c.vxlan) {
+  if (!c.vxlan && !c.gtp) {
     return;
   }
 
   match->l3matchLen = l3addrsLen;
+  if (c.gtp) {
+    match->len += PutGtpHdr(BUF_TAIL, loc->ulTEID, GTP_PDUTYPE_UL, loc->ulQFI);
+    match->f = MatchGtp;
+    if (c.gtpInner) {
+      match->len += PutIpv4Hdr(BUF_TAIL, loc->innerRemoteIP, loc->innerLocalIP);
+      match->len += PutUdpHdr(BUF_TAIL, UDPPortNDN, UDPPortNDN);
+    }
+    return;
+  }
+
   match->len += PutVxlanHdr(BUF_TAIL, loc->vxlan);
   match->len += PutEtherVlanHdr(BUF_TAIL, &loc->innerRemote, &loc->innerLocal, 0, EtherTypeNDN);
   match->f = MatchVxlan;
@@ -284,6 +350,10 @@ EthXdpLocator_Prepare(EthXdpLocator* xl, const EthLocator* loc)
                RTE_SIZEOF_FIELD(struct rte_ipv6_hdr, dst_addr));
   }
   xl->udpDst = rte_cpu_to_be_16(loc->localUDP);
+  if (c.gtp) {
+    xl->teid = rte_cpu_to_be_32(loc->ulTEID);
+    return;
+  }
   if (!c.vxlan) {
     xl->udpSrc = rte_cpu_to_be_16(loc->remoteUDP);
     return;
@@ -365,14 +435,34 @@ EthFlowPattern_Prepare(EthFlowPattern* flow, const EthLocator* loc)
   MASK(flow->udpMask.hdr.src_port);
   PutUdpHdr((uint8_t*)(&flow->udpSpec.hdr), loc->remoteUDP, loc->localUDP);
 
-  if (!c.vxlan) {
+  if (!c.vxlan && !c.gtp) {
     APPEND(UDP, udp);
     return;
   }
 
-  flow->udpMask.hdr.src_port = 0; // VXLAN packet can have any UDP source port
+  flow->udpMask.hdr.src_port = 0; // VXLAN and GTP-U packet can have any UDP source port
   APPEND(UDP, udp);
 
+  if (c.gtp) {
+    MASK(flow->gtpMask.hdr.teid);
+    flow->gtpSpec.hdr.teid = rte_cpu_to_be_32(loc->ulTEID);
+    APPEND(GTPU, gtp);
+    if (!c.gtpInner) {
+      return;
+    }
+
+    MASK(flow->innerIp4Mask.hdr.src_addr);
+    MASK(flow->innerIp4Mask.hdr.dst_addr);
+    PutIpv4Hdr((uint8_t*)(&flow->innerIp4Spec.hdr), loc->innerRemoteIP, loc->innerLocalIP);
+    APPEND(IPV4, innerIp4);
+
+    MASK(flow->innerUdpMask.hdr.dst_port);
+    MASK(flow->innerUdpMask.hdr.src_port);
+    PutUdpHdr((uint8_t*)(&flow->innerUdpSpec.hdr), UDPPortNDN, UDPPortNDN);
+    APPEND(UDP, innerUdp);
+    return;
+  }
+
   flow->vxlanMask.hdr.vx_vni = ~rte_cpu_to_be_32(0xFF); // don't mask reserved byte
   PutVxlanHdr((uint8_t*)(&flow->vxlanSpec.hdr), loc->vxlan);
   APPEND(VXLAN, vxlan);
@@ -414,6 +504,37 @@ TxMakeVxlanSrcPort(bool newBurst)
   return (RTE_PER_LCORE(txVxlanSrcPort) & VXLAN_SRCPORT_MASK) | VXLAN_SRCPORT_BASE;
 }
 
+__attribute__((nonnull)) static __rte_always_inline void
+TxGtp(const EthTxHdr* hdr, struct rte_mbuf* m, struct rte_udp_hdr* udp)
+{
+  EthGtpHdr* gtp = RTE_PTR_ADD(udp, sizeof(*udp));
+  uint16_t gtpLen = rte_be_to_cpu_16(udp->dgram_len) - sizeof(*udp);
+  gtp->length = rte_cpu_to_be_16(gtpLen - offsetof(EthGtpHdr, seqNum));
+
+  struct rte_ipv4_hdr* ip = RTE_PTR_ADD(gtp, sizeof(*gtp));
+  if ((uint8_t*)ip == rte_pktmbuf_mtod_offset(m, uint8_t*, hdr->len)) {
+    return;
+  }
+  struct rte_udp_hdr* innerUdp = RTE_PTR_ADD(ip, sizeof(*ip));
+  uint16_t ipLen = gtpLen - sizeof(*gtp);
+  ip->total_length = rte_cpu_to_be_16(ipLen);
+  innerUdp->dgram_len = rte_cpu_to_be_16(ipLen - sizeof(*ip));
+  ip->hdr_checksum = rte_ipv4_cksum(ip);
+}
+
+__attribute__((nonnull)) static __rte_always_inline void
+TxTunnel(const EthTxHdr* hdr, struct rte_mbuf* m, struct rte_udp_hdr* udp, bool newBurst)
+{
+  switch (hdr->tunnel) {
+    case 'V':
+      udp->src_port = rte_cpu_to_be_16(TxMakeVxlanSrcPort(newBurst));
+      break;
+    case 'G':
+      TxGtp(hdr, m, udp);
+      break;
+  }
+}
+
 __attribute__((nonnull)) static __rte_always_inline struct rte_ipv4_hdr*
 TxUdp4(const EthTxHdr* hdr, struct rte_mbuf* m, bool newBurst)
 {
@@ -423,9 +544,7 @@ TxUdp4(const EthTxHdr* hdr, struct rte_mbuf* m, bool newBurst)
   uint16_t ipLen = m->pkt_len - hdr->l2len;
   ip->total_length = rte_cpu_to_be_16(ipLen);
   udp->dgram_len = rte_cpu_to_be_16(ipLen - sizeof(*ip));
-  if (hdr->vxlanSrcPort) {
-    udp->src_port = rte_cpu_to_be_16(TxMakeVxlanSrcPort(newBurst));
-  }
+  TxTunnel(hdr, m, udp, newBurst);
   return ip;
 }
 
@@ -453,9 +572,7 @@ TxUdp6(const EthTxHdr* hdr, struct rte_mbuf* m, bool newBurst)
   struct rte_udp_hdr* udp = RTE_PTR_ADD(ip, sizeof(*ip));
   ip->payload_len = rte_cpu_to_be_16(m->pkt_len - hdr->l2len - sizeof(*ip));
   udp->dgram_len = ip->payload_len;
-  if (hdr->vxlanSrcPort) {
-    udp->src_port = rte_cpu_to_be_16(TxMakeVxlanSrcPort(newBurst));
-  }
+  TxTunnel(hdr, m, udp, newBurst);
   return ip;
 }
 
@@ -502,10 +619,19 @@ EthTxHdr_Prepare(EthTxHdr* hdr, const EthLocator* loc, bool hasChecksumOffloads)
   hdr->len += (c.v4 ? PutIpv4Hdr : PutIpv6Hdr)(BUF_TAIL, loc->localIP, loc->remoteIP);
   hdr->len += PutUdpHdr(BUF_TAIL, loc->localUDP, loc->remoteUDP);
 
+  if (c.gtp) {
+    hdr->tunnel = 'G';
+    hdr->len += PutGtpHdr(BUF_TAIL, loc->dlTEID, GTP_PDUTYPE_DL, loc->dlQFI);
+    if (c.gtpInner) {
+      hdr->len += PutIpv4Hdr(BUF_TAIL, loc->innerLocalIP, loc->innerRemoteIP);
+      hdr->len += PutUdpHdr(BUF_TAIL, UDPPortNDN, UDPPortNDN);
+    }
+    return;
+  }
   if (!c.vxlan) {
     return;
   }
-  hdr->vxlanSrcPort = true;
+  hdr->tunnel = 'V';
   hdr->len += PutVxlanHdr(BUF_TAIL, loc->vxlan);
   hdr->len += PutEtherVlanHdr(BUF_TAIL, &loc->innerLocal, &loc->innerRemote, 0, EtherTypeNDN);
 
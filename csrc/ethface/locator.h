@@ -6,11 +6,34 @@
 #include "../dpdk/ethdev.h"
 #include "xdp-locator.h"
 
+/**
+ * @brief GTP-U header with PDU session container extension header.
+ *
+ * The header has version 1, protocol type GTP, and E flag.
+ * The optional sequence number and N-PDU number fields are present but unused.
+ */
+typedef struct EthGtpHdr
+{
+  uint8_t flags;      ///< version, PT, E, S, PN
+  uint8_t msgType;    ///< message type, G-PDU
+  rte_be16_t length;  ///< length of the payload after TEID
+  rte_be32_t teid;    ///< tunnel endpoint identifier
+  rte_be16_t seqNum;  ///< sequence number
+  uint8_t npduNum;    ///< N-PDU number
+  uint8_t nextExt;    ///< next extension header type, PDU session container
+  uint8_t pscLength;  ///< PDU session container length in 4-octet units
+  uint8_t pscPduType; ///< PDU type in upper 4 bits
+  uint8_t pscQfi;     ///< QoS flow identifier in lower 6 bits
+  uint8_t pscNextExt; ///< next extension header type, none
+} __rte_packed EthGtpHdr;
+
 /** @brief EthFace header buffer length. */
 #define ETHHDR_MAXLEN                                                                              \
   (RTE_ETHER_HDR_LEN + RTE_VLAN_HLEN +                                                             \
-   spdk_max(sizeof(struct rte_ipv4_hdr), sizeof(struct rte_ipv6_hdr)) + RTE_ETHER_VXLAN_HLEN +     \
-   RTE_ETHER_HDR_LEN)
+   spdk_max(sizeof(struct rte_ipv4_hdr), sizeof(struct rte_ipv6_hdr)) +                            \
+   sizeof(struct rte_udp_hdr) +                                                                    \
+   spdk_max(sizeof(struct rte_vxlan_hdr) + RTE_ETHER_HDR_LEN,                                      \
+            sizeof(EthGtpHdr) + sizeof(struct rte_ipv4_hdr) + sizeof(struct rte_udp_hdr)))
 static_assert(ETHHDR_MAXLEN <= RTE_PKTMBUF_HEADROOM, "");
 
 /** @brief EthFace address information. */
@@ -28,6 +51,13 @@ typedef struct EthLocator
   uint32_t vxlan;
   struct rte_ether_addr innerLocal;
   struct rte_ether_addr innerRemote;
+
+  uint32_t ulTEID;
+  uint32_t dlTEID;
+  uint8_t ulQFI;
+  uint8_t dlQFI;
+  uint8_t innerLocalIP[16];
+  uint8_t innerRemoteIP[16];
 } EthLocator;
 
 /** @brief Determine whether two locators can coexist on the same port. */
@@ -41,6 +71,8 @@ typedef struct EthLocatorClass
   bool udp;           ///< is UDP tunnel?
   bool v4;            ///< is IPv4?
   bool vxlan;         ///< is VXLAN?
+  bool gtp;           ///< is GTP-U?
+  bool gtpInner;      ///< has inner IPv4 and UDP headers in GTP-U?
 } EthLocatorClass;
 
 /** @brief Classify EthFace locator. */
@@ -82,7 +114,7 @@ EthXdpLocator_Prepare(EthXdpLocator* xl, const EthLocator* loc);
 /** @brief EthFace rte_flow pattern. */
 typedef struct EthFlowPattern
 {
-  struct rte_flow_item pattern[7];
+  struct rte_flow_item pattern[8];
   struct rte_flow_item_eth ethSpec;
   struct rte_flow_item_eth ethMask;
   struct rte_flow_item_vlan vlanSpec;
@@ -97,6 +129,12 @@ typedef struct EthFlowPattern
   struct rte_flow_item_vxlan vxlanMask;
   struct rte_flow_item_eth innerEthSpec;
   struct rte_flow_item_eth innerEthMask;
+  struct rte_flow_item_gtp gtpSpec;
+  struct rte_flow_item_gtp gtpMask;
+  struct rte_flow_item_ipv4 innerIp4Spec;
+  struct rte_flow_item_ipv4 innerIp4Mask;
+  struct rte_flow_item_udp innerUdpSpec;
+  struct rte_flow_item_udp innerUdpMask;
 } EthFlowPattern;
 
 /** @brief Prepare rte_flow pattern from locator. */
@@ -111,7 +149,7 @@ struct EthTxHdr
   void (*f)(const EthTxHdr* hdr, struct rte_mbuf* m, bool newBurst);
   uint8_t len;
   uint8_t l2len;
-  bool vxlanSrcPort;
+  char tunnel; ///< 'V' for VXLAN, 'G' for GTP-U, 0 for others
   uint8_t buf[ETHHDR_MAXLEN];
 };
 
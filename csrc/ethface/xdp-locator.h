@@ -13,8 +13,9 @@
 typedef struct EthXdpLocator
 {
   uint32_t vxlan;       ///< VXLAN Network Identifier (big endian)
+  uint32_t teid;        ///< GTP-U tunnel endpoint identifier (big endian)
   uint16_t vlan;        ///< VLAN identifier (big endian)
-  uint16_t udpSrc;      ///< UDP source port (big endian, 0 for VXLAN)
+  uint16_t udpSrc;      ///< UDP source port (big endian, 0 for VXLAN and GTP-U)
   uint16_t udpDst;      ///< UDP destination port (big endian)
   uint8_t ether[2 * 6]; ///< outer Ethernet destination and source
   uint8_t inner[2 * 6]; ///< inner Ethernet destination and source
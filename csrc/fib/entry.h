@@ -61,6 +61,8 @@ struct FibEntry
    */
   uint8_t height;
 
+  uint8_t policy; ///< forwarding policy index
+
   FaceID nexthops[FibMaxNexthops];
 
   char b_[16];
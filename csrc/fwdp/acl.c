@@ -0,0 +1,111 @@
+#include "acl.h"
+#include "fwd.h"
+
+#include "../core/logger.h"
+
+N_LOG_INIT(FwFwd);
+
+FwAcl*
+FwAcl_Set(FwAcl** ref, FwAcl* acl)
+{
+  return rcu_xchg_pointer(ref, acl);
+}
+
+__attribute__((nonnull)) static __rte_always_inline bool
+FwAclRule_Match(const FwAcl* acl, const FwAclRule* rule, PktType pktType, FaceID rxFace,
+                const LpL3* lpl3, LName name)
+{
+  if (rule->pktTypes != 0 && (rule->pktTypes & RTE_BIT32(pktType)) == 0) {
+    return false;
+  }
+  if (rule->face != 0 && rule->face != rxFace) {
+    return false;
+  }
+  switch (rule->congMark) {
+    case FwAclCongMarkUnmarked:
+      if (lpl3->congMark != 0) {
+        return false;
+      }
+      break;
+    case FwAclCongMarkMarked:
+      if (lpl3->congMark == 0) {
+        return false;
+      }
+      break;
+    default:
+      break;
+  }
+  if (rule->matchReason != 0 && (pktType != PktNack || lpl3->nackReason != rule->matchReason)) {
+    return false;
+  }
+  LName prefix = {
+    .length = rule->prefixL,
+    .value = &acl->prefixV[rule->prefixOffset],
+  };
+  return LName_IsPrefix(prefix, name) >= 0;
+}
+
+/** @brief Determine whether rate limiter permits a packet. */
+__attribute__((nonnull)) static __rte_always_inline bool
+FwAclRule_RateLimit(const FwAclRule* rule, FwAclCnt* cnt, TscTime now)
+{
+  TscTime tat = RTE_MAX(cnt->tat, now);
+  if (unlikely((TscDuration)(tat - now) > rule->rateTolerance)) {
+    return false;
+  }
+  cnt->tat = tat + rule->rateInterval;
+  return true;
+}
+
+FwAclAction
+FwFwd_AclFilter(FwFwd* fwd, FwFwdCtx* ctx, NackReason* nackReason)
+{
+  FwAclAction act = FwAclActAllow;
+  rcu_read_lock();
+  const FwAcl* acl = rcu_dereference(fwd->acl);
+  if (likely(acl == NULL)) {
+    goto FINISH;
+  }
+
+  PktType pktType = (PktType)ctx->eventKind;
+  const LpL3* lpl3 = Packet_GetLpL3Hdr(ctx->npkt);
+  LName name = PName_ToLName(Packet_GetName(ctx->npkt));
+  for (uint32_t i = 0; i < acl->nRules; ++i) {
+    const FwAclRule* rule = &acl->rules[i];
+    if (!FwAclRule_Match(acl, rule, pktType, ctx->rxFace, lpl3, name)) {
+      continue;
+    }
+
+    FwAclCnt* cnt = &fwd->aclCnt[rule->slot];
+    if (unlikely(cnt->gen != rule->gen)) {
+      *cnt = (const FwAclCnt){ .gen = rule->gen };
+    }
+    ++cnt->nMatched;
+
+    act = rule->action;
+    switch (act) {
+      case FwAclActRateLimit:
+        act = FwAclRule_RateLimit(rule, cnt, rte_get_tsc_cycles()) ? FwAclActAllow : FwAclActDrop;
+        break;
+      case FwAclActNack:
+        if (pktType == PktInterest) {
+          *nackReason = rule->nackReason;
+        } else {
+          act = FwAclActDrop;
+        }
+        break;
+      default:
+        break;
+    }
+
+    if (act != FwAclActAllow) {
+      ++cnt->nDropped;
+    }
+    N_LOGD("^ acl-rule=%" PRIu32 " acl-act=%d", i, (int)act);
+    break;
+  }
+
+FINISH:
+  rcu_read_unlock();
+  return act;
+}
@@ -0,0 +1,54 @@
+#ifndef NDNDPDK_FWDP_ACL_H
+#define NDNDPDK_FWDP_ACL_H
+
+/** @file */
+
+#include "../core/urcu.h"
+#include "../dpdk/tsc.h"
+#include "../iface/faceid.h"
+#include "../ndni/packet.h"
+#include "enum.h"
+#include <urcu-pointer.h>
+
+/** @brief Packet filter rule. */
+typedef struct FwAclRule
+{
+  uint32_t gen;              ///< rule generation, distinguishes rules sharing a counter slot
+  uint16_t prefixOffset;     ///< name prefix offset in @c FwAcl.prefixV
+  uint16_t prefixL;          ///< name prefix TLV-LENGTH, 0 matches any name
+  FaceID face;               ///< incoming face, 0 matches any face
+  uint8_t pktTypes;          ///< bitmask of RTE_BIT32(PktType), 0 matches any packet type
+  FwAclCongMark congMark;    ///< congestion mark criteria
+  uint8_t matchReason;       ///< Nack reason to match, 0 matches any Nack reason
+  FwAclAction action;        ///< action on matched packets
+  uint8_t nackReason;        ///< Nack reason for @c FwAclActNack
+  uint8_t slot;              ///< counter slot index
+  TscDuration rateInterval;  ///< @c FwAclActRateLimit emission interval
+  TscDuration rateTolerance; ///< @c FwAclActRateLimit burst tolerance
+} FwAclRule;
+
+/** @brief Packet filter table, immutable after publishing. */
+typedef struct FwAcl
+{
+  uint32_t nRules;
+  FwAclRule rules[FwAclMaxRules];
+  uint8_t prefixV[FwAclPrefixBufLen];
+} FwAcl;
+
+/** @brief Per-rule counters and rate limiter state in a forwarding thread. */
+typedef struct FwAclCnt
+{
+  uint32_t gen;      ///< rule generation
+  TscTime tat;       ///< rate limiter theoretical arrival time
+  uint64_t nMatched; ///< packets matching the rule
+  uint64_t nDropped; ///< packets dropped or rejected by the rule
+} FwAclCnt;
+
+/**
+ * @brief Assign or clear FwAcl in an RCU-protected pointer.
+ * @return old pointer value.
+ */
+__attribute__((nonnull(1))) FwAcl*
+FwAcl_Set(FwAcl** ref, FwAcl* acl);
+
+#endif // NDNDPDK_FWDP_ACL_H
@@ -51,6 +51,7 @@ __attribute__((nonnull)) static void
 FwFwd_DataSatisfy(FwFwd* fwd, FwFwdCtx* ctx)
 {
   uint8_t upCongMark = Packet_GetLpL3Hdr(ctx->npkt)->congMark;
+  bool isLocalhost = FwScope_IsLocalhost(&Packet_GetDataHdr(ctx->npkt)->name);
   N_LOGD("^ pit-entry=%p(%s)", ctx->pitEntry, PitEntry_ToDebugString(ctx->pitEntry));
 
   PitDnIt it;
@@ -70,6 +71,11 @@ FwFwd_DataSatisfy(FwFwd* fwd, FwFwdCtx* ctx)
       N_LOGD("^ no-data-to=%" PRI_FaceID " drop=face-down", dn->face);
       continue;
     }
+    if (unlikely(isLocalhost) && !Face_IsLocal(dn->face)) {
+      N_LOGD("^ no-data-to=%" PRI_FaceID " drop=scope", dn->face);
+      ++fwd->nScopeTxDrop;
+      continue;
+    }
 
     Packet* outNpkt = Packet_Clone(ctx->npkt, &fwd->mp, Face_PacketTxAlign(dn->face));
     N_LOGD("^ data-to=%" PRI_FaceID " npkt=%p dn-token=%s", dn->face, outNpkt,
@@ -83,6 +89,7 @@ FwFwd_DataSatisfy(FwFwd* fwd, FwFwdCtx* ctx)
     LpL3* lpl3 = Packet_GetLpL3Hdr(outNpkt);
     lpl3->pitToken = dn->token;
     lpl3->congMark = RTE_MAX(dn->congMark, upCongMark);
+    lpl3->incomingFaceID = Face_IsLocal(dn->face) ? ctx->rxFace : 0;
     Face_Tx(dn->face, outNpkt);
   }
 
@@ -104,6 +111,23 @@ FwFwd_RxData(FwFwd* fwd, FwFwdCtx* ctx)
     return;
   }
 
+  if (unlikely(!Face_IsLocal(ctx->rxFace) &&
+               FwScope_IsLocalhost(&Packet_GetDataHdr(ctx->npkt)->name))) {
+    N_LOGD("^ drop=localhost-from-non-local");
+    ++fwd->nScopeRxDrop;
+    FwFwdCtx_FreePkt(ctx);
+    return;
+  }
+
+  // Data returning from crypto helper has passed packet filter
+  NackReason aclNackReason = NackNone;
+  if (!Packet_GetDataHdr(ctx->npkt)->hasDigest &&
+      unlikely(FwFwd_AclFilter(fwd, ctx, &aclNackReason) != FwAclActAllow)) {
+    N_LOGD("^ drop=acl");
+    FwFwdCtx_FreePkt(ctx);
+    return;
+  }
+
   PitFindResult pitFound = Pit_FindByData(fwd->pit, ctx->npkt, FwToken_GetPccToken(&ctx->rxToken));
   if (PitFindResult_Is(pitFound, PIT_FIND_NONE)) {
     FwFwd_DataUnsolicited(fwd, ctx);
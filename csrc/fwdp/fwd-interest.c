@@ -4,6 +4,7 @@
 
 #include "../core/logger.h"
 #include "../disk/store.h"
+#include "../pcct/pit-iterator.h"
 
 N_LOG_INIT(FwFwd);
 
@@ -13,7 +14,9 @@ FwFwd_InterestLookupFib(FwFwd* fwd, Packet* npkt, FibNexthopFilter* nhFlt)
   PInterest* interest = Packet_GetInterestHdr(npkt);
   FaceID dnFace = Packet_ToMbuf(npkt)->port;
 
-  if (likely(interest->nFwHints == 0)) {
+  // use Interest name if there's no forwarding hint or it has reached the producer region
+  if (likely(interest->nFwHints == 0) ||
+      FwNetRegion_InProducerRegion(rcu_dereference(fwd->netRegion), interest)) {
     FibEntry* entry = Fib_Lpm(fwd->fib, &interest->name);
     if (unlikely(entry == NULL)) {
       return NULL;
@@ -46,6 +49,21 @@ FwFwd_InterestLookupFib(FwFwd* fwd, Packet* npkt, FibNexthopFilter* nhFlt)
   return NULL;
 }
 
+/**
+ * @brief Determine NextHopFaceId of an incoming Interest.
+ * @return requested nexthop face, or 0 if absent.
+ *
+ * NextHopFaceId is honored only from local faces.
+ */
+__attribute__((nonnull)) static inline FaceID
+FwFwd_InterestNextHop(FwFwdCtx* ctx)
+{
+  if (likely(!Face_IsLocal(ctx->rxFace))) {
+    return 0;
+  }
+  return Packet_GetLpL3Hdr(ctx->npkt)->nextHopFaceID;
+}
+
 __attribute__((nonnull)) static void
 FwFwd_InterestRejectNack(FwFwd* fwd, FwFwdCtx* ctx, NackReason reason)
 {
@@ -72,16 +90,30 @@ FwFwd_InterestForward(FwFwd* fwd, FwFwdCtx* ctx)
     return;
   }
 
-  // insert DN record
-  PitDn* dn = PitEntry_InsertDn(ctx->pitEntry, fwd->pit, ctx->npkt);
+  FaceID nextHop = FwFwd_InterestNextHop(ctx);
+
+  // insert DN record, capping InterestLifetime according to policy
+  FwPolicy* policy = FwFwdCtx_GetPolicy(ctx);
+  bool isCapped = Packet_GetInterestHdr(ctx->npkt)->lifetime > policy->maxLifetime;
+  PitDn* dn = PitEntry_InsertDn(ctx->pitEntry, fwd->pit, ctx->npkt, policy->maxLifetime);
   if (unlikely(dn == NULL)) {
     N_LOGD("^ pit-entry=%p drop=PitDn-full nack-to=%" PRI_FaceID, ctx->pitEntry, ctx->rxFace);
     FwFwd_InterestRejectNack(fwd, ctx, NackCongestion);
     return;
   }
+  if (unlikely(isCapped)) {
+    ++policy->nLifetimeCapped;
+  }
   NULLize(ctx->npkt); // npkt is owned and possibly freed by pitEntry
   N_LOGD("^ pit-entry=%p(%s)", ctx->pitEntry, PitEntry_ToDebugString(ctx->pitEntry));
 
+  if (unlikely(nextHop != 0)) {
+    SgForwardInterestResult res = SgForwardInterest((SgCtx*)ctx, nextHop);
+    N_LOGD("^ nexthop-face=%" PRI_FaceID " fwd-res=%d", nextHop, (int)res);
+    ++fwd->nNextHopInterests;
+    return;
+  }
+
   uint64_t res = SgInvoke(ctx->fibEntry->strategy, ctx);
   NULLize(ctx->pitEntry); // strategy may have deleted PIT entry via SgReturnNacks
   N_LOGD("^ sg-res=%" PRIu64 " sg-forwarded=%d", res, ctx->nForwarded);
@@ -140,19 +172,46 @@ FwFwd_RxInterest(FwFwd* fwd, FwFwdCtx* ctx)
     return;
   }
 
-  // query FIB, reply Nack if no FIB match
-  rcu_read_lock();
-  FwFwdCtx_SetFibEntry(ctx, FwFwd_InterestLookupFib(fwd, ctx->npkt, &ctx->nhFlt));
-  if (unlikely(ctx->fibEntry == NULL)) {
-    N_LOGD("^ drop=no-FIB-match nack-to=%" PRI_FaceID, ctx->rxFace);
-    FwFwd_InterestRejectNack(fwd, ctx, NackNoRoute);
-    ++fwd->nNoFibMatch;
-    rcu_read_unlock();
+  if (unlikely(!Face_IsLocal(ctx->rxFace) && FwScope_IsLocalhost(&interest->name))) {
+    N_LOGD("^ drop=localhost-from-non-local");
+    ++fwd->nScopeRxDrop;
+    FwFwdCtx_FreePkt(ctx);
     return;
   }
-  N_LOGD("^ fh-index=%d fib-entry-depth=%" PRIu8 " sg-id=%d", interest->activeFwHint,
-         ctx->fibEntry->nComps, ctx->fibEntry->strategy->id);
-  ++ctx->fibEntryDyn->nRxInterests;
+
+  NackReason aclNackReason = NackNone;
+  switch (FwFwd_AclFilter(fwd, ctx, &aclNackReason)) {
+    case FwAclActAllow:
+      break;
+    case FwAclActNack:
+      N_LOGD("^ drop=acl nack-to=%" PRI_FaceID, ctx->rxFace);
+      FwFwd_InterestRejectNack(fwd, ctx, aclNackReason);
+      return;
+    default:
+      N_LOGD("^ drop=acl");
+      FwFwdCtx_FreePkt(ctx);
+      return;
+  }
+
+  rcu_read_lock();
+  FaceID nextHop = FwFwd_InterestNextHop(ctx);
+  if (unlikely(nextHop != 0)) {
+    // NextHopFaceId bypasses FIB lookup, as in NFD
+    N_LOGD("^ nexthop-face=%" PRI_FaceID " fib-lookup=skipped", nextHop);
+  } else {
+    // query FIB, reply Nack if no FIB match
+    FwFwdCtx_SetFibEntry(ctx, FwFwd_InterestLookupFib(fwd, ctx->npkt, &ctx->nhFlt));
+    if (unlikely(ctx->fibEntry == NULL)) {
+      N_LOGD("^ drop=no-FIB-match nack-to=%" PRI_FaceID, ctx->rxFace);
+      FwFwd_InterestRejectNack(fwd, ctx, NackNoRoute);
+      ++fwd->nNoFibMatch;
+      rcu_read_unlock();
+      return;
+    }
+    N_LOGD("^ fh-index=%d fib-entry-depth=%" PRIu8 " sg-id=%d", interest->activeFwHint,
+           ctx->fibEntry->nComps, ctx->fibEntry->strategy->id);
+    ++ctx->fibEntryDyn->nRxInterests;
+  }
 
   // lookup PIT-CS
   PitInsertResult pitIns = Pit_Insert(fwd->pit, ctx->npkt, ctx->fibEntry);
@@ -188,6 +247,38 @@ FwFwd_RxInterest(FwFwd* fwd, FwFwdCtx* ctx)
   rcu_read_unlock();
 }
 
+/**
+ * @brief Determine whether scope control permits forwarding the Interest to a nexthop.
+ *
+ * /localhost Interests can only be forwarded to local faces.
+ * /localhop Interests can be forwarded to non-local faces only if some downstream is local.
+ */
+__attribute__((nonnull)) static bool
+FwFwd_InterestScopeAllows(FwFwdCtx* ctx, FaceID nh)
+{
+  if (likely(Face_IsLocal(nh))) {
+    return true;
+  }
+
+  const PName* name = &Packet_GetInterestHdr(ctx->pitEntry->npkt)->name;
+  if (unlikely(FwScope_IsLocalhost(name))) {
+    return false;
+  }
+  if (unlikely(FwScope_IsLocalhop(name))) {
+    PitDnIt it;
+    for (PitDnIt_Init(&it, ctx->pitEntry); PitDnIt_Valid(&it); PitDnIt_Next(&it)) {
+      if (it.dn->face == 0) {
+        break;
+      }
+      if (Face_IsLocal(it.dn->face)) {
+        return true;
+      }
+    }
+    return false;
+  }
+  return true;
+}
+
 SgForwardInterestResult
 SgForwardInterest(SgCtx* ctx0, FaceID nh)
 {
@@ -200,14 +291,22 @@ SgForwardInterest(SgCtx* ctx0, FaceID nh)
     return SGFWDI_BADFACE;
   }
 
+  if (unlikely(!FwFwd_InterestScopeAllows(ctx, nh))) {
+    N_LOGD("^ no-interest-to=%" PRI_FaceID " drop=scope", nh);
+    ++fwd->nScopeTxDrop;
+    return SGFWDI_SCOPE;
+  }
+
   PitUp* up = PitEntry_ReserveUp(ctx->pitEntry, fwd->pit, nh);
   if (unlikely(up == NULL)) {
     N_LOGD("^ no-interest-to=%" PRI_FaceID " drop=PitUp-full", nh);
     return SGFWDI_ALLOCERR;
   }
 
+  FwPolicy* policy = FwFwdCtx_GetPolicy(ctx);
   if (PitUp_ShouldSuppress(up, now)) {
     N_LOGD("^ no-interest-to=%" PRI_FaceID " drop=suppressed", nh);
+    ++policy->nSuppressed;
     return SGFWDI_SUPPRESSED;
   }
 
@@ -235,14 +334,19 @@ SgForwardInterest(SgCtx* ctx0, FaceID nh)
 
   LpPitToken* outToken = &Packet_GetLpL3Hdr(outNpkt)->pitToken;
   FwToken_Set(outToken, fwd->id, PitEntry_GetToken(ctx->pitEntry));
+  if (Face_IsLocal(nh) && ctx->eventKind == SGEVT_INTEREST) {
+    Packet_GetLpL3Hdr(outNpkt)->incomingFaceID = ctx->rxFace;
+  }
   Mbuf_SetTimestamp(Packet_ToMbuf(outNpkt), ctx->rxTime); // for latency stats
 
   N_LOGD("^ interest-to=%" PRI_FaceID " npkt=%p " PRI_InterestGuiders " up-token=%s", nh, outNpkt,
          InterestGuiders_Fmt(guiders), LpPitToken_ToString(outToken));
-  Face_Tx(nh, outNpkt);
-  ++ctx->fibEntryDyn->nTxInterests;
+  Face_TxBurstClass(nh, &outNpkt, 1, (int)ctx->txClass - 1);
+  if (likely(ctx->fibEntryDyn != NULL)) {
+    ++ctx->fibEntryDyn->nTxInterests;
+  }
 
-  PitUp_RecordTx(up, ctx->pitEntry, now, guiders.nonce, &fwd->suppressCfg);
+  PitUp_RecordTx(up, ctx->pitEntry, now, guiders.nonce, &policy->suppress);
   ++ctx->nForwarded;
   return SGFWDI_OK;
 }
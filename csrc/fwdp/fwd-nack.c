@@ -8,7 +8,8 @@
 N_LOG_INIT(FwFwd);
 
 __attribute__((nonnull)) static void
-FwFwd_TxNacks(FwFwd* fwd, PitEntry* pitEntry, TscTime now, NackReason reason, uint8_t nackHopLimit)
+FwFwd_TxNacks(FwFwd* fwd, PitEntry* pitEntry, TscTime now, NackReason reason, uint8_t nackHopLimit,
+              FaceID upFace)
 {
   PitDnIt it;
   for (PitDnIt_Init(&it, pitEntry); PitDnIt_Valid(&it); PitDnIt_Next(&it)) {
@@ -39,7 +40,9 @@ FwFwd_TxNacks(FwFwd* fwd, PitEntry* pitEntry, TscTime now, NackReason reason, ui
     NDNDPDK_ASSERT(output !=
                    NULL); // cannot fail because Interest_ModifyGuiders result is already aligned
 
-    Packet_GetLpL3Hdr(output)->pitToken = dn->token;
+    LpL3* lpl3 = Packet_GetLpL3Hdr(output);
+    lpl3->pitToken = dn->token;
+    lpl3->incomingFaceID = Face_IsLocal(dn->face) ? upFace : 0;
     N_LOGD("^ nack-to=%" PRI_FaceID " reason=%s npkt=%p nonce=%08" PRIx32 " dn-token=%s", dn->face,
            NackReason_ToString(reason), output, dn->nonce, LpPitToken_ToString(&dn->token));
     Face_Tx(dn->face, output);
@@ -52,7 +55,7 @@ SgReturnNacks(SgCtx* ctx0, NackReason reason)
   FwFwdCtx* ctx = (FwFwdCtx*)ctx0;
   NDNDPDK_ASSERT(ctx->eventKind == SGEVT_INTEREST);
 
-  FwFwd_TxNacks(ctx->fwd, ctx->pitEntry, rte_get_tsc_cycles(), reason, 1);
+  FwFwd_TxNacks(ctx->fwd, ctx->pitEntry, rte_get_tsc_cycles(), reason, 1, 0);
 }
 
 __attribute__((nonnull)) static bool
@@ -90,7 +93,7 @@ FwFwd_RxNackDuplicate(FwFwd* fwd, FwFwdCtx* ctx)
     ++ctx->fibEntryDyn->nTxInterests;
   }
 
-  PitUp_RecordTx(up, ctx->pitEntry, now, guiders.nonce, &fwd->suppressCfg);
+  PitUp_RecordTx(up, ctx->pitEntry, now, guiders.nonce, &FwFwdCtx_GetPolicy(ctx)->suppress);
   return true;
 }
 
@@ -103,6 +106,13 @@ FwFwd_ProcessNack(FwFwd* fwd, FwFwdCtx* ctx)
 
   N_LOGD("RxNack nack-from=%" PRI_FaceID " npkt=%p up-token=%s reason=%" PRIu8, ctx->rxFace,
          ctx->npkt, LpPitToken_ToString(&ctx->rxToken), reason);
+
+  NackReason aclNackReason = NackNone;
+  if (unlikely(FwFwd_AclFilter(fwd, ctx, &aclNackReason) != FwAclActAllow)) {
+    N_LOGD("^ drop=acl");
+    return;
+  }
+
   if (unlikely(ctx->rxToken.length != FwTokenLength)) {
     N_LOGD("^ drop=bad-token-length");
     return;
@@ -181,7 +191,7 @@ FwFwd_ProcessNack(FwFwd* fwd, FwFwdCtx* ctx)
   }
 
   // return Nacks to downstream and erase PIT entry
-  FwFwd_TxNacks(fwd, ctx->pitEntry, ctx->rxTime, leastSevere, nackHopLimit);
+  FwFwd_TxNacks(fwd, ctx->pitEntry, ctx->rxTime, leastSevere, nackHopLimit, ctx->rxFace);
   Pit_Erase(fwd->pit, ctx->pitEntry);
   NULLize(ctx->pitEntry);
 }
@@ -17,6 +17,7 @@ static_assert(offsetof(SgCtx, pkt) == offsetof(FwFwdCtx, pkt), "");
 static_assert(offsetof(SgCtx, fibEntry) == offsetof(FwFwdCtx, fibEntry), "");
 static_assert(offsetof(SgCtx, fibEntryDyn) == offsetof(FwFwdCtx, fibEntryDyn), "");
 static_assert(offsetof(SgCtx, pitEntry) == offsetof(FwFwdCtx, pitEntry), "");
+static_assert(offsetof(SgCtx, txClass) == offsetof(FwFwdCtx, txClass), "");
 static_assert(sizeof(SgCtx) == offsetof(FwFwdCtx, endofSgCtx), "");
 
 typedef void (*RxFunc)(FwFwd* fwd, FwFwdCtx* ctx);
@@ -12,9 +12,22 @@
 #include "../pcct/cs.h"
 #include "../pcct/pit.h"
 #include "../strategyapi/api.h"
+#include "acl.h"
+#include "region.h"
+#include "scope.h"
 
 typedef struct FwFwdCtx FwFwdCtx;
 
+/** @brief Per-prefix forwarding policy, referenced by @c FibEntry.policy . */
+typedef struct FwPolicy
+{
+  PitSuppressConfig suppress; ///< Interest suppression configuration
+  uint32_t maxLifetime;       ///< maximum InterestLifetime (millis)
+
+  uint64_t nSuppressed;     ///< Interests suppressed by this policy
+  uint64_t nLifetimeCapped; ///< Interests whose InterestLifetime was capped by this policy
+} FwPolicy;
+
 /** @brief Forwarding thread. */
 typedef struct FwFwd
 {
@@ -29,20 +42,27 @@ typedef struct FwFwd
   Cs* cs;
 
   pcg32_random_t sgRng;
-  PitSuppressConfig suppressCfg;
+  FwPolicy policies[FibMaxPolicies];
 
   uint8_t id;          ///< fwd process id
   uint8_t fibDynIndex; ///< FibEntry.dyn index
 
-  uint64_t nNoFibMatch;   ///< Interests dropped due to no FIB match
-  uint64_t nDupNonce;     ///< Interests dropped due to duplicate nonce
-  uint64_t nSgNoFwd;      ///< Interests not forwarded by strategy
-  uint64_t nNackMismatch; ///< Nack dropped due to outdated nonce
+  uint64_t nNoFibMatch;       ///< Interests dropped due to no FIB match
+  uint64_t nDupNonce;         ///< Interests dropped due to duplicate nonce
+  uint64_t nSgNoFwd;          ///< Interests not forwarded by strategy
+  uint64_t nNackMismatch;     ///< Nack dropped due to outdated nonce
+  uint64_t nScopeRxDrop;      ///< Interests and Data dropped due to /localhost from non-local face
+  uint64_t nScopeTxDrop;      ///< Interests and Data not sent to non-local face due to scope control
+  uint64_t nNextHopInterests; ///< Interests forwarded per NextHopFaceId, bypassing strategy
 
   PacketMempools mp; ///< mempools for packet modification
 
   struct rte_ring* cryptoHelper; ///< queue to crypto helper
 
+  FwAcl* acl;                     ///< RCU-protected packet filter table
+  FwAclCnt aclCnt[FwAclMaxRules]; ///< per-rule packet filter counters
+  FwNetRegion* netRegion;         ///< RCU-protected network region table
+
   /** @brief Statistics of latency from packet arrival to start processing. */
   RunningStat latencyStat;
 } FwFwd;
@@ -59,6 +79,16 @@ FwFwd_RxData(FwFwd* fwd, FwFwdCtx* ctx);
 __attribute__((nonnull)) void
 FwFwd_RxNack(FwFwd* fwd, FwFwdCtx* ctx);
 
+/**
+ * @brief Apply packet filter on an incoming packet.
+ * @param[out] nackReason Nack reason, assigned if returning @c FwAclActNack .
+ * @return @c FwAclActAllow if the packet should be processed further;
+ *         @c FwAclActDrop if the packet should be dropped;
+ *         @c FwAclActNack if the Interest should be rejected with a Nack.
+ */
+__attribute__((nonnull)) FwAclAction
+FwFwd_AclFilter(FwFwd* fwd, FwFwdCtx* ctx, NackReason* nackReason);
+
 /**
  * @brief Per-packet context in forwarding.
  *
@@ -83,6 +113,7 @@ struct FwFwdCtx
   FibEntry* fibEntry;       // T,I,D,N
   FibEntryDyn* fibEntryDyn; // T,I,D,N
   PitEntry* pitEntry;       // T,I,D,N
+  uint8_t txClass;          // T,I,D,N
 
   // end of SgCtx fields
   RTE_MARKER endofSgCtx;
@@ -102,6 +133,16 @@ FwFwdCtx_FreePkt(FwFwdCtx* ctx)
   NULLize(ctx->npkt);
 }
 
+/** @brief Retrieve forwarding policy of @c fibEntry , or the default policy if it's unset. */
+__attribute__((nonnull, returns_nonnull)) static inline FwPolicy*
+FwFwdCtx_GetPolicy(FwFwdCtx* ctx)
+{
+  if (unlikely(ctx->fibEntry == NULL)) {
+    return &ctx->fwd->policies[0];
+  }
+  return &ctx->fwd->policies[ctx->fibEntry->policy];
+}
+
 /** @brief Assign @c fibEntry and @c fibEntryDyn . */
 __attribute__((nonnull(1))) static inline void
 FwFwdCtx_SetFibEntry(FwFwdCtx* ctx, FibEntry* fibEntry)
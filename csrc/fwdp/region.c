@@ -0,0 +1,29 @@
+#include "region.h"
+
+FwNetRegion*
+FwNetRegion_Set(FwNetRegion** ref, FwNetRegion* table)
+{
+  return rcu_xchg_pointer(ref, table);
+}
+
+bool
+FwNetRegion_InProducerRegion(const FwNetRegion* table, const PInterest* interest)
+{
+  if (table == NULL) {
+    return false;
+  }
+
+  for (int i = 0, end = interest->nFwHints; i < end; ++i) {
+    LName fh = { .length = interest->fwHintL[i], .value = interest->fwHintV[i] };
+    for (uint32_t j = 0; j < table->nRegions; ++j) {
+      LName region = {
+        .length = table->nameL[j],
+        .value = &table->nameV[table->nameOffset[j]],
+      };
+      if (LName_IsPrefix(fh, region) >= 0) {
+        return true;
+      }
+    }
+  }
+  return false;
+}
@@ -0,0 +1,36 @@
+#ifndef NDNDPDK_FWDP_REGION_H
+#define NDNDPDK_FWDP_REGION_H
+
+/** @file */
+
+#include "../core/urcu.h"
+#include "../ndni/interest.h"
+#include "enum.h"
+#include <urcu-pointer.h>
+
+/** @brief Network region table, immutable after publishing. */
+typedef struct FwNetRegion
+{
+  uint32_t nRegions;
+  uint16_t nameOffset[FwNetRegionMax]; ///< name offset in @c nameV
+  uint16_t nameL[FwNetRegionMax];      ///< name TLV-LENGTH
+  uint8_t nameV[FwNetRegionBufLen];
+} FwNetRegion;
+
+/**
+ * @brief Assign or clear FwNetRegion in an RCU-protected pointer.
+ * @return old pointer value.
+ */
+__attribute__((nonnull(1))) FwNetRegion*
+FwNetRegion_Set(FwNetRegion** ref, FwNetRegion* table);
+
+/**
+ * @brief Determine whether the Interest has reached the producer region.
+ * @param table network region table; NULL means empty table.
+ * @return true if some forwarding hint is a prefix of some network region name.
+ * @pre Calling thread holds rcu_read_lock.
+ */
+__attribute__((nonnull(2))) bool
+FwNetRegion_InProducerRegion(const FwNetRegion* table, const PInterest* interest);
+
+#endif // NDNDPDK_FWDP_REGION_H
@@ -0,0 +1,35 @@
+#ifndef NDNDPDK_FWDP_SCOPE_H
+#define NDNDPDK_FWDP_SCOPE_H
+
+/** @file */
+
+#include "../ndni/name.h"
+
+/** @brief Determine whether @p name starts with @p comp component. */
+__attribute__((nonnull)) static __rte_always_inline bool
+FwScope_HasFirstComponent_(const PName* name, const uint8_t* comp, uint16_t compL)
+{
+  return LName_IsPrefix((LName){ .length = compL, .value = comp }, PName_ToLName(name)) >= 0;
+}
+
+/** @brief Determine whether @p name is under /localhost prefix. */
+__attribute__((nonnull)) static inline bool
+FwScope_IsLocalhost(const PName* name)
+{
+  static const uint8_t comp[] = {
+    TtGenericNameComponent, 9, 'l', 'o', 'c', 'a', 'l', 'h', 'o', 's', 't',
+  };
+  return FwScope_HasFirstComponent_(name, comp, sizeof(comp));
+}
+
+/** @brief Determine whether @p name is under /localhop prefix. */
+__attribute__((nonnull)) static inline bool
+FwScope_IsLocalhop(const PName* name)
+{
+  static const uint8_t comp[] = {
+    TtGenericNameComponent, 8, 'l', 'o', 'c', 'a', 'l', 'h', 'o', 'p',
+  };
+  return FwScope_HasFirstComponent_(name, comp, sizeof(comp));
+}
+
+#endif // NDNDPDK_FWDP_SCOPE_H
@@ -13,6 +13,11 @@ FaceRx_Input(Face* face, int rxThread, struct rte_mbuf* pkt)
 
   Packet* npkt = Packet_FromMbuf(pkt);
   if (unlikely(!rte_pktmbuf_is_contiguous(pkt) || !Packet_Parse(npkt, face->impl->rxParseFor))) {
+    if (pkt->pkt_len == 0) { // IDLE packet or empty frame
+      ++rxt->nIdle;
+      rte_pktmbuf_free(pkt);
+      return NULL;
+    }
     ++rxt->nDecodeErr;
     N_LOGD("l2-decode-error face=%" PRI_FaceID " thread=%d", face->id, rxThread);
     rte_pktmbuf_free(pkt);
@@ -3,3 +3,52 @@
 #include "../core/logger.h"
 
 Face gFaces[UINT16_MAX + 1];
+
+__attribute__((nonnull)) static uint8_t
+FaceTxClasses_Classify(const FaceTxClasses* txc, Packet* npkt)
+{
+  PktType t = Packet_GetType(npkt);
+  const PName* name = NULL;
+  switch (t) {
+    case PktInterest:
+      name = &Packet_GetInterestHdr(npkt)->name;
+      break;
+    case PktData:
+      name = &Packet_GetDataHdr(npkt)->name;
+      break;
+    case PktNack:
+      name = &Packet_GetNackHdr(npkt)->interest.name;
+      break;
+    default: // unparsed packet
+      return txc->byType[PktType_ToFull(t)];
+  }
+
+  int rule = LNamePrefixFilter_Find(PName_ToLName(name), MaxFaceTxClassRules, txc->ruleL, txc->ruleV);
+  if (rule >= 0) {
+    return txc->ruleClass[rule];
+  }
+  return txc->byType[t];
+}
+
+void
+Face_TxBurstClassified_(Face* face, Packet** npkts, uint16_t count, int hint)
+{
+  FaceTxClasses* txc = &face->impl->txc;
+  struct rte_mbuf* vec[MaxFaceTxClasses][count];
+  uint16_t n[MaxFaceTxClasses] = { 0 };
+  for (uint16_t i = 0; i < count; ++i) {
+    uint8_t cls = hint >= 0 ? (uint8_t)hint : FaceTxClasses_Classify(txc, npkts[i]);
+    cls = RTE_MIN(cls, face->nTxClasses - 1);
+    vec[cls][n[cls]++] = Packet_ToMbuf(npkts[i]);
+  }
+
+  for (uint8_t cls = 0; cls < face->nTxClasses; ++cls) {
+    if (n[cls] == 0) {
+      continue;
+    }
+    uint32_t nRej = Mbuf_EnqueueVector(vec[cls], n[cls], txc->cls[cls].queue, true);
+    if (unlikely(nRej > 0)) {
+      __atomic_fetch_add(&txc->cls[cls].nDrops, nRej, __ATOMIC_RELAXED);
+    }
+  }
+}
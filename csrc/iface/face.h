@@ -6,6 +6,7 @@
 #include "input-demux.h"
 #include "reassembler.h"
 
+#include "../core/running-stat.h"
 #include "../core/urcu.h"
 #include "../pdump/source.h"
 #include <urcu/rcuhlist.h>
@@ -15,6 +16,7 @@ typedef struct FaceRxThread
 {
   uint64_t nFrames[PktMax]; ///< accepted L3 packets; nFrames[0] is nOctets
   uint64_t nDecodeErr;      ///< decode errors
+  uint64_t nIdle;           ///< NDNLPv2 IDLE packets
   Reassembler reass;
 } __rte_cache_aligned FaceRxThread;
 
@@ -26,13 +28,55 @@ typedef struct FaceTxThread
   uint64_t nL3Fragmented; ///< L3 packets that required fragmentation
   uint64_t nL3OverLength; ///< dropped L3 packets due to over length
   uint64_t nAllocFails;   ///< dropped L3 packets due to allocation failure
+  uint64_t nIdle;         ///< NDNLPv2 IDLE packets
+  TscTime nextIdle;       ///< when to transmit IDLE packet if no other frame is transmitted
 
   uint64_t nFrames[PktMax]; ///< sent+dropped L2 frames and L3 packets
   uint64_t nOctets;         ///< sent+dropped L2 octets (including LpHeader)
   uint64_t nDroppedFrames;  ///< dropped L2 frames
   uint64_t nDroppedOctets;  ///< dropped L2 octets
+
+  uint64_t nClassPkts[MaxFaceTxClasses]; ///< L3 packets dequeued from each TX class
+  uint8_t wrrNext;                       ///< first TX class in next weighted round robin
+
+  bool clearLatency;          ///< request to clear latency stats, set by main thread
+  RunningStatH sojournStat;   ///< time in before-Tx queue, in TSC duration
+  RunningStatH residenceStat; ///< time since packet arrival, in TSC duration
 } __rte_cache_aligned FaceTxThread;
 
+/** @brief Request TX thread to clear latency stats. */
+__attribute__((nonnull)) static inline void
+FaceTxThread_ClearLatency(FaceTxThread* txt)
+{
+  __atomic_store_n(&txt->clearLatency, true, __ATOMIC_RELEASE);
+}
+
+/** @brief Face TX class. */
+typedef struct FaceTxClass
+{
+  struct rte_ring* queue; ///< output queue
+  uint64_t nDrops;        ///< L3 packets dropped due to full queue, updated atomically
+  uint32_t weight;        ///< max packets dequeued per burst in weighted scheduling, 0 in strict priority
+} FaceTxClass;
+
+/**
+ * @brief Face TX classes and classifier.
+ *
+ * An outgoing L3 packet is placed into a TX class chosen by the first of:
+ * @li TX class hint passed to @c Face_TxBurstClass .
+ * @li First matching name prefix rule.
+ * @li L3 packet type.
+ */
+typedef struct FaceTxClasses
+{
+  FaceTxClass cls[MaxFaceTxClasses];
+  uint8_t byType[PktMax];                 ///< TX class of each L3 packet type
+  uint8_t ruleClass[MaxFaceTxClassRules]; ///< TX class of each name prefix rule
+  uint16_t ruleL[MaxFaceTxClassRules];    ///< name prefix TLV-LENGTH of each rule
+  uint8_t ruleV[NameMaxLength];           ///< name prefix TLV-VALUE of rules, written consecutively
+  bool weighted;                          ///< weighted round robin if true, strict priority if false
+} FaceTxClasses;
+
 /**
  * @brief Transmit a burst of L2 frames.
  * @param pkts L2 frames.
@@ -58,8 +102,19 @@ typedef struct FaceImpl
   PacketMempools txMempools; ///< mempools for fragmentation
   Face_TxBurstFunc txBurst;
   PdumpSourceRef txPdump;
+  FaceTxClasses txc;     ///< meaningful only if Face.nTxClasses > 0
+  uint64_t nOutputDrops; ///< L3 packets dropped due to full outputQueue, updated atomically
 
   ParseFor rxParseFor;
+  bool txResidenceStat; ///< whether to collect FaceTxThread.residenceStat
+
+  /**
+   * @brief Liveness probing interval.
+   *
+   * If positive, an NDNLPv2 IDLE packet is transmitted when no other frame has been
+   * transmitted during this interval.
+   */
+  TscDuration txIdleInterval;
 
   uint8_t priv[] __rte_cache_aligned;
 } FaceImpl;
@@ -73,6 +128,8 @@ struct Face
   PacketTxAlign txAlign;
   FaceID id;
   FaceState state;
+  bool isLocal;       ///< whether the face is local, for /localhost and /localhop scope control
+  uint8_t nTxClasses; ///< number of TX classes; 0 means only outputQueue is used
 };
 static_assert(sizeof(Face) <= RTE_CACHE_LINE_SIZE, "");
 
@@ -104,6 +161,14 @@ Face_IsDown(FaceID faceID)
   return face->state != FaceStateUp;
 }
 
+/** @brief Return whether the face is local. */
+static inline bool
+Face_IsLocal(FaceID faceID)
+{
+  Face* face = Face_Get(faceID);
+  return face->isLocal;
+}
+
 /** @brief Retrieve face TX alignment requirement. */
 static inline PacketTxAlign
 Face_PacketTxAlign(FaceID faceID)
@@ -112,23 +177,58 @@ Face_PacketTxAlign(FaceID faceID)
   return face->txAlign;
 }
 
+/**
+ * @brief Enqueue a burst of packets on TX class output queues.
+ * @param hint TX class of all packets, or -1 to classify each packet.
+ * @pre face->nTxClasses > 0
+ */
+__attribute__((nonnull)) void
+Face_TxBurstClassified_(Face* face, Packet** npkts, uint16_t count, int hint);
+
 /**
  * @brief Enqueue a burst of packets on the output queue to be transmitted by the output thread.
  * @param npkts array of L3 packets; face takes ownership.
  * @param count size of @p npkts array.
+ * @param hint TX class of all packets, or -1 to classify each packet per face configuration.
+ *             It is ignored if the face does not have TX classes.
  *
  * This function is thread-safe.
  */
 __attribute__((nonnull)) static inline void
-Face_TxBurst(FaceID faceID, Packet** npkts, uint16_t count)
+Face_TxBurstClass(FaceID faceID, Packet** npkts, uint16_t count, int hint)
 {
   Face* face = Face_Get(faceID);
-  if (likely(face->state == FaceStateUp)) {
-    Mbuf_EnqueueVector((struct rte_mbuf**)npkts, count, face->outputQueue, true);
-    // TODO count rejects
-  } else {
+  if (unlikely(face->state != FaceStateUp)) {
     rte_pktmbuf_free_bulk((struct rte_mbuf**)npkts, count);
+    return;
+  }
+
+  TscTime now = rte_get_tsc_cycles();
+  for (uint16_t i = 0; i < count; ++i) {
+    Mbuf_SetEnqueueTime(Packet_ToMbuf(npkts[i]), now); // for sojourn time stats
   }
+
+  if (likely(face->nTxClasses == 0)) {
+    uint32_t nRej = Mbuf_EnqueueVector((struct rte_mbuf**)npkts, count, face->outputQueue, true);
+    if (unlikely(nRej > 0)) {
+      __atomic_fetch_add(&face->impl->nOutputDrops, nRej, __ATOMIC_RELAXED);
+    }
+  } else {
+    Face_TxBurstClassified_(face, npkts, count, hint);
+  }
+}
+
+/**
+ * @brief Enqueue a burst of packets on the output queue to be transmitted by the output thread.
+ * @param npkts array of L3 packets; face takes ownership.
+ * @param count size of @p npkts array.
+ *
+ * This function is thread-safe.
+ */
+__attribute__((nonnull)) static inline void
+Face_TxBurst(FaceID faceID, Packet** npkts, uint16_t count)
+{
+  Face_TxBurstClass(faceID, npkts, count, -1);
 }
 
 /**
@@ -27,12 +27,75 @@ TxLoop_TxFrames(Face* face, int txThread, struct rte_mbuf** frames, uint16_t cou
   }
 }
 
+/** @brief Transmit an NDNLPv2 IDLE packet for liveness probing. */
+__attribute__((nonnull)) static void
+TxLoop_TxIdle(Face* face, int txThread)
+{
+  FaceTxThread* txt = &face->impl->tx[txThread];
+  struct rte_mbuf* frame = rte_pktmbuf_alloc(face->impl->txMempools.header);
+  if (unlikely(frame == NULL)) {
+    ++txt->nAllocFails;
+    return;
+  }
+
+  frame->data_off = RTE_PKTMBUF_HEADROOM + LpHeaderHeadroom;
+  TlvEncoder_PrependTL(frame, TtLpPacket, 0);
+  Packet_SetType(Packet_FromMbuf(frame), PktFragment);
+  ++txt->nIdle;
+  TxLoop_TxFrames(face, txThread, &frame, 1);
+}
+
+/**
+ * @brief Dequeue from TX class output queues.
+ *
+ * In strict priority scheduling, a lower priority class is served only if all higher priority
+ * classes have fewer packets than the burst size.
+ * In weighted scheduling, each class is served up to its weight, starting from a different class
+ * in each burst.
+ */
+__attribute__((nonnull)) static uint16_t
+TxLoop_DequeueClasses(Face* face, FaceTxThread* txt, Packet** npkts)
+{
+  FaceTxClasses* txc = &face->impl->txc;
+  uint8_t nClasses = face->nTxClasses;
+  uint8_t first = 0;
+  if (txc->weighted) {
+    first = txt->wrrNext;
+    txt->wrrNext = (first + 1) % nClasses;
+  }
+
+  uint16_t count = 0;
+  for (uint8_t i = 0; i < nClasses && count < MaxBurstSize; ++i) {
+    uint8_t cls = (first + i) % nClasses;
+    FaceTxClass* c = &txc->cls[cls];
+    uint16_t limit = MaxBurstSize - count;
+    if (txc->weighted) {
+      limit = RTE_MIN(limit, c->weight);
+    }
+    uint16_t n = rte_ring_dequeue_burst(c->queue, (void**)&npkts[count], limit, NULL);
+    txt->nClassPkts[cls] += n;
+    count += n;
+  }
+  return count;
+}
+
 __attribute__((nonnull)) static uint16_t
 TxLoop_Transfer(Face* face, int txThread)
 {
   FaceTxThread* txt = &face->impl->tx[txThread];
+  if (unlikely(__atomic_load_n(&txt->clearLatency, __ATOMIC_ACQUIRE))) {
+    RunningStatH_Clear(&txt->sojournStat);
+    RunningStatH_Clear(&txt->residenceStat);
+    __atomic_store_n(&txt->clearLatency, false, __ATOMIC_RELEASE);
+  }
+
   Packet* npkts[MaxBurstSize];
-  uint16_t count = rte_ring_dequeue_burst(face->outputQueue, (void**)npkts, MaxBurstSize, NULL);
+  uint16_t count = 0;
+  if (likely(face->nTxClasses == 0)) {
+    count = rte_ring_dequeue_burst(face->outputQueue, (void**)npkts, MaxBurstSize, NULL);
+  } else {
+    count = TxLoop_DequeueClasses(face, txt, npkts);
+  }
 
   struct rte_mbuf* frames[MaxBurstSize + LpMaxFragments];
   uint16_t nFrames = 0;
@@ -43,11 +106,16 @@ TxLoop_Transfer(Face* face, int txThread)
   TscTime now = rte_get_tsc_cycles();
   for (uint16_t i = 0; i < count; ++i) {
     Packet* npkt = npkts[i];
+    struct rte_mbuf* m = Packet_ToMbuf(npkt);
     PktType framePktType = PktType_ToFull(Packet_GetType(npkt));
     ++txt->nFrames[framePktType];
 
+    RunningStatH_Push(&txt->sojournStat, now - Mbuf_GetEnqueueTime(m));
+    if (face->impl->txResidenceStat) {
+      RunningStatH_Push(&txt->residenceStat, now - Mbuf_GetTimestamp(m));
+    }
+
     if (hrlRing != NULL) {
-      struct rte_mbuf* m = Packet_ToMbuf(npkt);
       TscDuration latency = now - Mbuf_GetTimestamp(m);
       switch (framePktType) {
         case PktInterest:
@@ -78,6 +146,16 @@ TxLoop_Transfer(Face* face, int txThread)
     HrlogRing_Post(hrlRing, hrl, nHrls);
   }
 
+  TscDuration idleInterval = face->impl->txIdleInterval;
+  if (unlikely(idleInterval > 0)) {
+    if (count > 0) {
+      txt->nextIdle = now + idleInterval;
+    } else if (now >= txt->nextIdle) {
+      TxLoop_TxIdle(face, txThread);
+      txt->nextIdle = now + idleInterval;
+    }
+  }
+
   return count;
 }
 
@@ -110,6 +110,18 @@ LpHeader_Parse(LpHeader* lph, struct rte_mbuf* pkt)
         }
         break;
       }
+      case TtIncomingFaceID: {
+        if (unlikely(!TlvDecoder_ReadNniTo(&d, length, &lph->l3.incomingFaceID))) {
+          return false;
+        }
+        break;
+      }
+      case TtNextHopFaceID: {
+        if (unlikely(!TlvDecoder_ReadNniTo(&d, length, &lph->l3.nextHopFaceID))) {
+          return false;
+        }
+        break;
+      }
       case TtCongestionMark: {
         if (unlikely(!TlvDecoder_ReadNniTo(&d, length, &lph->l3.congMark))) {
           return false;
@@ -131,6 +143,20 @@ FOUND_PAYLOAD:;
   return lph->l2.fragIndex < lph->l2.fragCount;
 }
 
+typedef struct LpFaceIDF
+{
+  unaligned_uint32_t faceIDTL;
+  unaligned_uint16_t faceIDV;
+} __rte_packed LpFaceIDF;
+
+__attribute__((nonnull)) static __rte_always_inline void
+LpHeader_PrependFaceID(struct rte_mbuf* pkt, uint32_t tl, uint16_t id)
+{
+  LpFaceIDF* f = (LpFaceIDF*)rte_pktmbuf_prepend(pkt, sizeof(LpFaceIDF));
+  f->faceIDTL = tl;
+  f->faceIDV = rte_cpu_to_be_16(id);
+}
+
 void
 LpHeader_Prepend(struct rte_mbuf* pkt, const LpL3* l3, const LpL2* l2)
 {
@@ -150,6 +176,18 @@ LpHeader_Prepend(struct rte_mbuf* pkt, const LpL3* l3, const LpL2* l2)
       f->congMarkV = l3->congMark;
     }
 
+    if (unlikely(l3->nextHopFaceID != 0)) {
+      LpHeader_PrependFaceID(
+        pkt, TlvEncoder_ConstTL3(TtNextHopFaceID, RTE_SIZEOF_FIELD(LpFaceIDF, faceIDV)),
+        l3->nextHopFaceID);
+    }
+
+    if (unlikely(l3->incomingFaceID != 0)) {
+      LpHeader_PrependFaceID(
+        pkt, TlvEncoder_ConstTL3(TtIncomingFaceID, RTE_SIZEOF_FIELD(LpFaceIDF, faceIDV)),
+        l3->incomingFaceID);
+    }
+
     if (unlikely(l3->nackReason != NackNone)) {
       if (unlikely(l3->nackReason == NackUnspecified)) {
         TlvEncoder_PrependTL(pkt, TtNack, 0);
@@ -62,6 +62,8 @@ typedef struct LpL3
   uint8_t nackReason;
   uint8_t congMark;
   LpPitToken pitToken;
+  uint16_t nextHopFaceID;  ///< NextHopFaceId, 0 if absent
+  uint16_t incomingFaceID; ///< IncomingFaceId, 0 if absent
 } LpL3;
 
 /** @brief Parsed NDNLPv2 header. */
@@ -82,6 +84,7 @@ typedef struct LpHeader
  * @li PIT token
  * @li network nack
  * @li congestion mark
+ * @li NextHopFaceId and IncomingFaceId
  *
  * This function does not check whether header fields are applicable to network layer packet type,
  * because network layer type is unknown before reassembly. For example, it would accept Nack
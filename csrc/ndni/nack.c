@@ -46,6 +46,10 @@ Nack_FromInterest(Packet* npkt, NackReason reason, PacketMempools* mp, PacketTxA
   }
 
   Packet_SetType(npkt, PktSNack);
-  Packet_GetLpL3Hdr(npkt)->nackReason = reason;
+  LpL3* lpl3 = Packet_GetLpL3Hdr(npkt);
+  lpl3->nackReason = reason;
+  // local control headers of the received Interest must not be echoed back to downstream
+  lpl3->nextHopFaceID = 0;
+  lpl3->incomingFaceID = 0;
   return npkt;
 }
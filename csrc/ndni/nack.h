@@ -31,6 +31,7 @@ typedef struct PNack
  * @return Nack packet. It may be different from @p npkt .
  * @pre PktType is @c PktInterest or @c PktSInterest .
  * @post PktType is @c PktSNack .
+ * @post NextHopFaceId and IncomingFaceId in LpL3 are cleared.
  */
 __attribute__((nonnull)) Packet*
 Nack_FromInterest(Packet* npkt, NackReason reason, PacketMempools* mp, PacketTxAlign align);
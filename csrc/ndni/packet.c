@@ -21,7 +21,7 @@ Packet_Parse(Packet* npkt, ParseFor parseFor)
   }
 
   if (unlikely(pkt->pkt_len == 0)) {
-    // there isn't any feature that depends on IDLE packets yet
+    // IDLE packet is rejected, but caller may recognize it by pkt_len==0
     return false;
   }
 
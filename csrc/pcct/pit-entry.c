@@ -79,6 +79,10 @@ PitEntry_ToDebugString(PitEntry* entry)
 FibEntry*
 PitEntry_FindFibEntry(PitEntry* entry, Fib* fib)
 {
+  if (unlikely(entry->fibSeqNum == 0)) {
+    return NULL;
+  }
+
   PInterest* interest = Packet_GetInterestHdr(entry->npkt);
   LName name = { .length = entry->fibPrefixL, .value = interest->name.value };
   if (unlikely(interest->activeFwHint >= 0)) {
@@ -149,7 +153,7 @@ PitEntry_FindDuplicateNonce(PitEntry* entry, uint32_t nonce, FaceID dnFace)
 }
 
 PitDn*
-PitEntry_InsertDn(PitEntry* entry, Pit* pit, Packet* npkt)
+PitEntry_InsertDn(PitEntry* entry, Pit* pit, Packet* npkt, uint32_t maxLifetime)
 {
   struct rte_mbuf* pkt = Packet_ToMbuf(npkt);
   FaceID face = pkt->port;
@@ -190,7 +194,7 @@ PitEntry_InsertDn(PitEntry* entry, Pit* pit, Packet* npkt)
   dn->congMark = lpl3->congMark;
   dn->canBePrefix = interest->canBePrefix;
   dn->nonce = interest->nonce;
-  uint32_t lifetime = RTE_MIN(interest->lifetime, PIT_MAX_LIFETIME);
+  uint32_t lifetime = RTE_MIN(interest->lifetime, RTE_MIN(maxLifetime, PIT_MAX_LIFETIME));
   dn->expiry = Mbuf_GetTimestamp(pkt) + TscDuration_FromMillis(lifetime);
 
   // record CanBePrefix and prefer CBP=1 for representative Interest
@@ -51,9 +51,18 @@ struct PitEntryExt
   PitEntryExt* next;
 };
 
-__attribute__((nonnull)) static inline void
+__attribute__((nonnull(1, 2))) static inline void
 PitEntry_SetFibEntry_(PitEntry* entry, PInterest* interest, const FibEntry* fibEntry)
 {
+  memset(entry->sgScratch, 0, PitScratchSize);
+  if (unlikely(fibEntry == NULL)) {
+    // FIB sequence numbers start from 1, so that zero indicates absence of FIB reference
+    entry->fibPrefixL = 0;
+    entry->fibSeqNum = 0;
+    entry->fibPrefixHash = 0;
+    return;
+  }
+
   entry->fibPrefixL = fibEntry->nameL;
   entry->fibSeqNum = fibEntry->seqNum;
   PName* name = &interest->name;
@@ -61,14 +70,14 @@ PitEntry_SetFibEntry_(PitEntry* entry, PInterest* interest, const FibEntry* fibE
     name = &interest->fwHint;
   }
   entry->fibPrefixHash = PName_ComputePrefixHash(name, fibEntry->nComps);
-  memset(entry->sgScratch, 0, PitScratchSize);
 }
 
 /**
  * @brief Initialize a PIT entry.
  * @param npkt the Interest packet.
+ * @param fibEntry FIB entry, or NULL if the Interest is forwarded without FIB lookup.
  */
-__attribute__((nonnull)) static inline void
+__attribute__((nonnull(1, 2))) static inline void
 PitEntry_Init(PitEntry* entry, Packet* npkt, const FibEntry* fibEntry)
 {
   PInterest* interest = Packet_GetInterestHdr(npkt);
@@ -117,11 +126,12 @@ PitEntry_GetToken(PitEntry* entry);
 /**
  * @brief Reference FIB entry from PIT entry, clear scratch if FIB entry changed.
  * @param npkt the Interest packet.
+ * @param fibEntry FIB entry, or NULL to retain existing FIB reference.
  */
-__attribute__((nonnull)) static inline void
+__attribute__((nonnull(1, 2))) static inline void
 PitEntry_RefreshFibEntry(PitEntry* entry, Packet* npkt, const FibEntry* fibEntry)
 {
-  if (likely(entry->fibSeqNum == fibEntry->seqNum)) {
+  if (unlikely(fibEntry == NULL) || likely(entry->fibSeqNum == fibEntry->seqNum)) {
     return;
   }
 
@@ -163,10 +173,11 @@ PitEntry_FindDuplicateNonce(PitEntry* entry, uint32_t nonce, FaceID rxFace);
  * @brief Insert new DN record, or update existing DN record.
  * @param entry PIT entry, must be initialized.
  * @param npkt received Interest; will take ownership unless returning NULL.
+ * @param maxLifetime maximum InterestLifetime in millis; @c PIT_MAX_LIFETIME applies regardless.
  * @return DN record, or NULL if no slot is available.
  */
 __attribute__((nonnull)) PitDn*
-PitEntry_InsertDn(PitEntry* entry, Pit* pit, Packet* npkt);
+PitEntry_InsertDn(PitEntry* entry, Pit* pit, Packet* npkt, uint32_t maxLifetime);
 
 /**
  * @brief Find existing UP record.
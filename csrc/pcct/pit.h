@@ -27,6 +27,7 @@ Pit_SetSgTimerCb(Pit* pit, Pit_SgTimerCb cb, uintptr_t ctx);
 /**
  * @brief Insert or find a PIT entry for the given Interest.
  * @param npkt Interest packet.
+ * @param fibEntry FIB entry, or NULL if the Interest is forwarded without FIB lookup.
  *
  * The PIT-CS lookup includes forwarding hint. PInterest's @c activeFh field
  * indicates which fwhint is in use, and setting it to -1 disables fwhint.
@@ -38,7 +39,7 @@ Pit_SetSgTimerCb(Pit* pit, Pit_SgTimerCb cb, uintptr_t ctx);
  * When a new PIT entry is inserted, the PIT entry owns @p npkt but does not
  * free it, so the caller may continue using it until @c PitEntry_InsertDn.
  */
-__attribute__((nonnull)) PitInsertResult
+__attribute__((nonnull(1, 2))) PitInsertResult
 Pit_Insert(Pit* pit, Packet* npkt, const FibEntry* fibEntry);
 
 /**
@@ -53,6 +53,14 @@ typedef struct SgCtx
 
   /** @brief PIT entry. */
   SgPitEntry* pitEntry;
+
+  /**
+   * @brief TX class hint for Interests forwarded by SgForwardInterest.
+   *
+   * 0 means the TX class is chosen by face configuration.
+   * n (n>0) means TX class n-1; it is ignored if the face does not have TX classes.
+   */
+  uint8_t txClass;
 } SgCtx;
 
 /** @brief Convert milliseconds to TscDuration. */
@@ -114,6 +122,7 @@ typedef enum SgForwardInterestResult
   SGFWDI_NONONCE,    ///< upstream has rejected all nonces
   SGFWDI_SUPPRESSED, ///< forwarding is suppressed
   SGFWDI_HOPZERO,    ///< HopLimit has become zero
+  SGFWDI_SCOPE,      ///< forwarding to the face would violate scope control
 } SgForwardInterestResult;
 
 /**
@@ -1,7 +1,12 @@
 package ethdev
 
 import (
+	"sync"
+	"time"
+
 	"github.com/usnistgov/ndn-dpdk/core/events"
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+	"go.uber.org/zap"
 )
 
 var closeEmitter = events.NewEmitter()
@@ -11,3 +16,111 @@ var closeEmitter = events.NewEmitter()
 func OnClose(dev EthDev, cb func()) (cancel func()) {
 	return closeEmitter.Once(dev.ID(), cb)
 }
+
+// LinkPollInterval is the interval of polling link status for OnLinkChange callbacks.
+var LinkPollInterval = 200 * time.Millisecond
+
+// LinkEvent describes a link status change of a port.
+type LinkEvent struct {
+	ID        int       `json:"nid" gqldesc:"DPDK port identifier."`
+	Name      string    `json:"name" gqldesc:"Port name."`
+	IsDown    bool      `json:"isDown" gqldesc:"Whether the port is down."`
+	Timestamp time.Time `json:"timestamp" gqldesc:"When the change was detected."`
+}
+
+const evtLinkChange = "LinkChange"
+
+var (
+	linkEmitter     = events.NewEmitter()
+	linkMonitorLock sync.Mutex
+	linkMonitorSubs int
+	linkMonitorStop chan struct{}
+)
+
+// OnLinkChange registers a callback when link status of any port changes.
+// Link status is polled at LinkPollInterval, so that it works with any driver.
+// Polling runs while there is at least one registered callback.
+// The callback is invoked on a goroutine and should not block.
+// Returns a function that cancels the callback registration.
+func OnLinkChange(cb func(evt LinkEvent)) (cancel func()) {
+	cancelOn := linkEmitter.On(evtLinkChange, cb)
+
+	linkMonitorLock.Lock()
+	defer linkMonitorLock.Unlock()
+	if linkMonitorSubs++; linkMonitorSubs == 1 {
+		linkMonitorStop = make(chan struct{})
+		go monitorLinks(linkMonitorStop)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cancelOn()
+			linkMonitorLock.Lock()
+			defer linkMonitorLock.Unlock()
+			if linkMonitorSubs--; linkMonitorSubs == 0 {
+				close(linkMonitorStop)
+			}
+		})
+	}
+}
+
+type linkStatus struct {
+	id     int
+	name   string
+	isDown bool
+	port   zap.Field
+}
+
+// pollLinks reads link status of all ports.
+// It runs on the main thread so that it does not race with port closing.
+func pollLinks() []linkStatus {
+	return eal.CallMain(func() (list []linkStatus) {
+		for _, dev := range List() {
+			list = append(list, linkStatus{
+				id:     dev.ID(),
+				name:   dev.Name(),
+				isDown: dev.IsDown(),
+				port:   dev.ZapField("port"),
+			})
+		}
+		return
+	}).([]linkStatus)
+}
+
+func monitorLinks(stop <-chan struct{}) {
+	isDown := map[int]bool{}
+	ticker := time.NewTicker(LinkPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		seen := map[int]bool{}
+		for _, st := range pollLinks() {
+			seen[st.id] = true
+			prev, ok := isDown[st.id]
+			isDown[st.id] = st.isDown
+			if !ok || prev == st.isDown {
+				continue
+			}
+
+			evt := LinkEvent{
+				ID:        st.id,
+				Name:      st.name,
+				IsDown:    st.isDown,
+				Timestamp: time.Now(),
+			}
+			logger.Info("link status changed", st.port, zap.Bool("down", st.isDown))
+			linkEmitter.Emit(evtLinkChange, evt)
+		}
+		for id := range isDown {
+			if !seen[id] {
+				delete(isDown, id)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package ethdev_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
+	"github.com/usnistgov/ndn-dpdk/dpdk/ethdev"
+	"github.com/usnistgov/ndn-dpdk/dpdk/ethdev/ethringdev"
+)
+
+func TestLinkEvents(t *testing.T) {
+	assert, require := makeAR(t)
+
+	defer func(d time.Duration) { ethdev.LinkPollInterval = d }(ethdev.LinkPollInterval)
+	ethdev.LinkPollInterval = 10 * time.Millisecond
+
+	sch, e := graphql.NewSchema(*gqlserver.Schema)
+	require.NoError(e)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	results := graphql.Subscribe(graphql.Params{
+		Schema:        sch,
+		RequestString: `subscription { ethLinkEvents { nid name isDown } }`,
+		Context:       ctx,
+	})
+
+	pair, e := ethringdev.NewPair(ethringdev.PairConfig{RxPool: directMp})
+	require.NoError(e)
+	defer pair.Close()
+	time.Sleep(5 * ethdev.LinkPollInterval)
+	pair.PortA.Start(pair.EthDevConfig())
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case res := <-results:
+			require.Empty(res.Errors)
+			evt := res.Data.(map[string]any)["ethLinkEvents"].(map[string]any)
+			if evt["nid"] != pair.PortA.ID() {
+				continue
+			}
+			assert.Equal(pair.PortA.Name(), evt["name"])
+			assert.Equal(false, evt["isDown"])
+			return
+		case <-timeout:
+			require.Fail("no link event")
+		}
+	}
+}
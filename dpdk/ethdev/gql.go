@@ -7,6 +7,8 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"time"
 	"unsafe"
 
 	"github.com/graphql-go/graphql"
@@ -17,7 +19,8 @@ import (
 
 // GraphQL types.
 var (
-	GqlEthDevType *gqlserver.NodeType[EthDev]
+	GqlEthDevType    *gqlserver.NodeType[EthDev]
+	GqlLinkEventType *graphql.Object
 )
 
 func init() {
@@ -154,4 +157,32 @@ func init() {
 			return port, nil
 		},
 	})
+
+	GqlLinkEventType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "EthLinkEvent",
+		Description: "Ethernet device link status change.",
+		Fields: gqlserver.BindFields[LinkEvent](gqlserver.FieldTypes{
+			reflect.TypeOf(time.Time{}): graphql.DateTime,
+		}),
+	})
+	GqlLinkEventType.AddFieldConfig("port", &graphql.Field{
+		Type:        GqlEthDevType.Object,
+		Description: "Ethernet device, if it still exists.",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			evt := p.Source.(LinkEvent)
+			if port := FromID(evt.ID); port != nil {
+				return port, nil
+			}
+			return nil, nil
+		},
+	})
+
+	gqlserver.AddSubscription(&graphql.Field{
+		Name:        "ethLinkEvents",
+		Description: "Ethernet device link status changes.",
+		Type:        graphql.NewNonNull(GqlLinkEventType),
+		Subscribe: func(p graphql.ResolveParams) (any, error) {
+			return gqlserver.PublishEvents(p, OnLinkChange)
+		},
+	})
 }
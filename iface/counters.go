@@ -23,11 +23,12 @@ type RxCounters struct {
 	RxDecodeErrs   uint64 `json:"rxDecodeErrs" gqldesc:"RX decode errors."`
 	RxReassPackets uint64 `json:"rxReassPackets" gqldesc:"RX packets that were reassembled."`
 	RxReassDrops   uint64 `json:"rxReassDrops" gqldesc:"RX frames that were dropped by reassembler."`
+	RxIdle         uint64 `json:"rxIdle" gqldesc:"RX NDNLPv2 IDLE packets."`
 }
 
 func (cnt RxCounters) String() string {
-	return fmt.Sprintf("%dfrm %db %dI %dD %dN %derr reass=(%dpkt %ddrop)",
-		cnt.RxFrames, cnt.RxOctets, cnt.RxInterests, cnt.RxData, cnt.RxNacks, cnt.RxDecodeErrs, cnt.RxReassPackets, cnt.RxReassDrops)
+	return fmt.Sprintf("%dfrm %db %dI %dD %dN %didle %derr reass=(%dpkt %ddrop)",
+		cnt.RxFrames, cnt.RxOctets, cnt.RxInterests, cnt.RxData, cnt.RxNacks, cnt.RxIdle, cnt.RxDecodeErrs, cnt.RxReassPackets, cnt.RxReassDrops)
 }
 
 func (cnt *RxCounters) readFrom(c *C.FaceRxThread) {
@@ -39,8 +40,9 @@ func (cnt *RxCounters) readFrom(c *C.FaceRxThread) {
 	cnt.RxDecodeErrs = uint64(c.nDecodeErr)
 	cnt.RxReassPackets = uint64(c.reass.nDeliverPackets)
 	cnt.RxReassDrops = uint64(c.reass.nDropFragments)
+	cnt.RxIdle = uint64(c.nIdle)
 
-	cnt.RxFrames = cnt.RxInterests + cnt.RxData + cnt.RxNacks - cnt.RxReassPackets + uint64(c.reass.nDeliverFragments) + cnt.RxReassDrops
+	cnt.RxFrames = cnt.RxInterests + cnt.RxData + cnt.RxNacks - cnt.RxReassPackets + uint64(c.reass.nDeliverFragments) + cnt.RxReassDrops + cnt.RxIdle
 }
 
 // TxCounters contains face/queue TX counters.
@@ -55,11 +57,12 @@ type TxCounters struct {
 	TxFragBad   uint64 `json:"txFragBad" gqldesc:"TX fragmentation failures."`
 	TxAllocErrs uint64 `json:"txAllocErrs" gqldesc:"TX allocation errors."`
 	TxDropped   uint64 `json:"txDropped" gqldesc:"TX dropped L2 frames due to full queue."`
+	TxIdle      uint64 `json:"txIdle" gqldesc:"TX NDNLPv2 IDLE packets."`
 }
 
 func (cnt TxCounters) String() string {
-	return fmt.Sprintf("%dfrm %db %dI %dD %dN frag=(%dgood %dbad) alloc=%derr %ddropped",
-		cnt.TxFrames, cnt.TxOctets, cnt.TxInterests, cnt.TxData, cnt.TxNacks, cnt.TxFragGood, cnt.TxFragBad, cnt.TxAllocErrs, cnt.TxDropped)
+	return fmt.Sprintf("%dfrm %db %dI %dD %dN %didle frag=(%dgood %dbad) alloc=%derr %ddropped",
+		cnt.TxFrames, cnt.TxOctets, cnt.TxInterests, cnt.TxData, cnt.TxNacks, cnt.TxIdle, cnt.TxFragGood, cnt.TxFragBad, cnt.TxAllocErrs, cnt.TxDropped)
 }
 
 func (cnt *TxCounters) readFrom(c *C.FaceTxThread) {
@@ -73,6 +76,7 @@ func (cnt *TxCounters) readFrom(c *C.FaceTxThread) {
 	cnt.TxFragBad = uint64(c.nL3OverLength + c.nAllocFails)
 	cnt.TxAllocErrs = uint64(c.nAllocFails)
 	cnt.TxDropped = uint64(c.nDroppedFrames)
+	cnt.TxIdle = uint64(c.nIdle)
 }
 
 // Counters contains face counters.
@@ -81,6 +85,10 @@ type Counters struct {
 	TxCounters
 
 	RxThreads []RxCounters `json:"rxThreads"`
+
+	TxQueueDropped uint64 `json:"txQueueDropped" gqldesc:"TX dropped L3 packets due to full output queue, if TX classes are disabled."`
+
+	TxClasses []TxClassCounters `json:"txClasses,omitempty" gqldesc:"Per TX class counters, if TX classes are enabled."`
 }
 
 func (cnt Counters) String() string {
@@ -119,6 +127,8 @@ func (f *face) Counters() (cnt Counters) {
 	cnt.sumRx()
 
 	cnt.TxCounters.readFrom(&c.impl.tx[0])
+	cnt.TxQueueDropped = uint64(c.impl.nOutputDrops)
+	cnt.TxClasses = readTxClassCounters(c)
 
 	return cnt
 }
@@ -17,6 +17,12 @@ const (
 	// MaxFaceTxThreads is the maximum number of TX threads in a face.
 	MaxFaceTxThreads = 1
 
+	// MaxFaceTxClasses is the maximum number of TX classes in a face.
+	MaxFaceTxClasses = 4
+
+	// MaxFaceTxClassRules is the maximum number of name prefix rules in TX classification.
+	MaxFaceTxClassRules = 8
+
 	// MinReassemblerCapacity is the minimum partial message store capacity in the reassembler.
 	MinReassemblerCapacity = 4
 
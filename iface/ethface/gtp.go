@@ -0,0 +1,118 @@
+package ethface
+
+import (
+	"errors"
+	"math"
+	"net/netip"
+
+	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/iface/ethport"
+)
+
+const (
+	// MaxGtpQFI is the maximum GTP-U QoS Flow Identifier.
+	MaxGtpQFI = 1<<6 - 1
+)
+
+// Error conditions.
+var (
+	ErrGtpTEID    = errors.New("invalid GTP-U Tunnel Endpoint Identifier")
+	ErrGtpQFI     = errors.New("invalid GTP-U QoS Flow Identifier")
+	ErrGtpInnerIP = errors.New("invalid GTP-U inner IPv4 address")
+)
+
+const schemeGtp = "gtp"
+
+// GtpLocator describes an Ethernet GTP-U face.
+//
+// This face acts as the core network side of a GTP-U tunnel.
+// It receives uplink G-PDUs and transmits downlink G-PDUs.
+// Each G-PDU has a PDU session container extension header.
+type GtpLocator struct {
+	IPLocator
+
+	// UlTEID is the tunnel endpoint identifier of uplink traffic, which is used to match incoming packets.
+	// This must be between 1 and MaxUint32.
+	UlTEID int `json:"ulTEID"`
+
+	// UlQFI is the QoS flow identifier of uplink traffic.
+	// It is not used in matching incoming packets.
+	// This must be between 0 and MaxGtpQFI.
+	UlQFI int `json:"ulQFI"`
+
+	// DlTEID is the tunnel endpoint identifier of downlink traffic, which is set on outgoing packets.
+	// This must be between 1 and MaxUint32.
+	DlTEID int `json:"dlTEID"`
+
+	// DlQFI is the QoS flow identifier of downlink traffic.
+	// This must be between 0 and MaxGtpQFI.
+	DlQFI int `json:"dlQFI"`
+
+	// InnerLocalIP is the inner local IPv4 address.
+	// If both InnerLocalIP and InnerRemoteIP are specified, each NDN packet is carried in inner IPv4
+	// and UDP headers, with port 6363 on both ends.
+	// If both are omitted, each NDN packet directly follows the GTP-U header.
+	InnerLocalIP netip.Addr `json:"innerLocalIP,omitempty"`
+
+	// InnerRemoteIP is the inner remote IPv4 address.
+	InnerRemoteIP netip.Addr `json:"innerRemoteIP,omitempty"`
+}
+
+// Scheme returns "gtp".
+func (GtpLocator) Scheme() string {
+	return schemeGtp
+}
+
+// Validate checks Locator fields.
+func (loc GtpLocator) Validate() error {
+	if e := loc.IPLocator.Validate(); e != nil {
+		return e
+	}
+
+	switch {
+	case loc.UlTEID <= 0, loc.UlTEID > math.MaxUint32, loc.DlTEID <= 0, loc.DlTEID > math.MaxUint32:
+		return ErrGtpTEID
+	case loc.UlQFI < 0, loc.UlQFI > MaxGtpQFI, loc.DlQFI < 0, loc.DlQFI > MaxGtpQFI:
+		return ErrGtpQFI
+	}
+
+	if loc.InnerLocalIP.IsValid() || loc.InnerRemoteIP.IsValid() {
+		local, remote := loc.InnerLocalIP.Unmap(), loc.InnerRemoteIP.Unmap()
+		if !local.Is4() || !remote.Is4() {
+			return ErrGtpInnerIP
+		}
+	}
+
+	return nil
+}
+
+// EthCLocator implements ethport.Locator interface.
+func (loc GtpLocator) EthCLocator() (c ethport.CLocator) {
+	c = loc.IPLocator.cLoc()
+	c.LocalUDP = ethport.UDPPortGTP
+	c.RemoteUDP = ethport.UDPPortGTP
+	c.UlTEID = uint32(loc.UlTEID)
+	c.UlQFI = uint8(loc.UlQFI)
+	c.DlTEID = uint32(loc.DlTEID)
+	c.DlQFI = uint8(loc.DlQFI)
+	if loc.InnerLocalIP.IsValid() && loc.InnerRemoteIP.IsValid() {
+		c.InnerLocalIP = loc.InnerLocalIP.As16()
+		c.InnerRemoteIP = loc.InnerRemoteIP.As16()
+	}
+	return
+}
+
+// CreateFace creates a GTP-U face.
+func (loc GtpLocator) CreateFace() (face iface.Face, e error) {
+	port, e := loc.FaceConfig.FindPort(loc.Local.HardwareAddr)
+	if e != nil {
+		return nil, e
+	}
+
+	loc.FaceConfig.HideFaceConfigFromJSON()
+	return ethport.NewFace(port, loc)
+}
+
+func init() {
+	iface.RegisterLocatorScheme[GtpLocator](schemeGtp)
+}
@@ -97,6 +97,22 @@ func TestLocatorCoexist(t *testing.T) {
 		`{"scheme":"vxlan","vxlan":1`+innerA+ipA+etherA,
 		`{"scheme":"vxlan","vxlan":1`+innerB+ipA+etherA)
 
+	// "gtp" scheme
+	const teidA = `,"ulTEID":1,"ulQFI":1,"dlTEID":1,"dlQFI":1`
+	const teidB = `,"ulTEID":2,"ulQFI":1,"dlTEID":1,"dlQFI":1`
+	conflict( // same IP addresses, same TEIDs
+		`{"scheme":"gtp"`+teidA+ipA+etherA,
+		`{"scheme":"gtp"`+teidA+ipA+etherA)
+	conflict( // same IP addresses, same ulTEID, different inner IP addresses
+		`{"scheme":"gtp","innerLocalIP":"10.0.0.1","innerRemoteIP":"10.0.0.2"`+teidA+ipA+etherA,
+		`{"scheme":"gtp","innerLocalIP":"10.0.0.1","innerRemoteIP":"10.0.0.3"`+teidA+ipA+etherA)
+	coexist( // different IP addresses
+		`{"scheme":"gtp"`+teidA+ipA+etherA,
+		`{"scheme":"gtp"`+teidA+ipB+etherA)
+	coexist( // same IP addresses, different ulTEID
+		`{"scheme":"gtp"`+teidA+ipA+etherA,
+		`{"scheme":"gtp"`+teidB+ipA+etherA)
+
 	// mixed schemes
 	coexist( // "ether" with "udpe"
 		`{"scheme":"ether"`+etherA,
@@ -113,6 +129,15 @@ func TestLocatorCoexist(t *testing.T) {
 	coexist( // "udp" with "vxlan", different ports
 		`{"scheme":"udpe","localUDP":6363,"remoteUDP":6363`+ipA+etherA,
 		`{"scheme":"vxlan","vxlan":1`+innerA+ipA+etherA)
+	conflict( // "udp" with "gtp", same localUDP
+		`{"scheme":"udpe","localUDP":2152,"remoteUDP":4444`+ipA+etherA,
+		`{"scheme":"gtp"`+teidA+ipA+etherA)
+	coexist( // "udp" with "gtp", different ports
+		`{"scheme":"udpe","localUDP":6363,"remoteUDP":6363`+ipA+etherA,
+		`{"scheme":"gtp"`+teidA+ipA+etherA)
+	coexist( // "vxlan" with "gtp"
+		`{"scheme":"vxlan","vxlan":1`+innerA+ipA+etherA,
+		`{"scheme":"gtp"`+teidA+ipA+etherA)
 }
 
 func TestLocatorRxMatch(t *testing.T) {
@@ -182,6 +207,30 @@ func TestLocatorRxMatch(t *testing.T) {
 		"innerLocal": "02:00:00:00:00:03",
 		"innerRemote": "02:00:00:00:00:04"
 	}`)
+	addMatcher("gtp", `{
+		"scheme": "gtp",
+		"local": "02:00:00:00:00:01",
+		"remote": "02:00:00:00:00:02",
+		"localIP": "192.168.37.1",
+		"remoteIP": "192.168.37.2",
+		"ulTEID": 268435457,
+		"ulQFI": 1,
+		"dlTEID": 536870913,
+		"dlQFI": 2
+	}`)
+	addMatcher("gtp-inner", `{
+		"scheme": "gtp",
+		"local": "02:00:00:00:00:01",
+		"remote": "02:00:00:00:00:02",
+		"localIP": "192.168.37.1",
+		"remoteIP": "192.168.37.2",
+		"ulTEID": 268435458,
+		"ulQFI": 1,
+		"dlTEID": 536870914,
+		"dlQFI": 2,
+		"innerLocalIP": "10.0.0.1",
+		"innerRemoteIP": "10.0.0.2"
+	}`)
 
 	payload := make(gopacket.Payload, 200)
 	rand.Read([]byte(payload))
@@ -298,6 +347,52 @@ func TestLocatorRxMatch(t *testing.T) {
 		&layers.UDP{SrcPort: 16363, DstPort: 26363},
 	)
 
+	ipI0 := net.ParseIP("10.0.0.0")
+	ipI1 := net.ParseIP("10.0.0.1")
+	ipI2 := net.ParseIP("10.0.0.2")
+	onlyMatch("gtp",
+		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv4},
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ip42, DstIP: ip41},
+		&layers.UDP{SrcPort: 65000, DstPort: 2152},
+		makeGtpHdr(0x10000001, 1),
+	)
+	onlyMatch("", // wrong TEID
+		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv4},
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ip42, DstIP: ip41},
+		&layers.UDP{SrcPort: 65000, DstPort: 2152},
+		makeGtpHdr(0x20000001, 1),
+	)
+	onlyMatch("", // wrong PDU type
+		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv4},
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ip42, DstIP: ip41},
+		&layers.UDP{SrcPort: 65000, DstPort: 2152},
+		makeGtpHdr(0x10000001, 0),
+	)
+	onlyMatch("gtp-inner",
+		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv4},
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ip42, DstIP: ip41},
+		&layers.UDP{SrcPort: 65000, DstPort: 2152},
+		makeGtpHdr(0x10000002, 1),
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ipI2, DstIP: ipI1},
+		&layers.UDP{SrcPort: 6363, DstPort: 6363},
+	)
+	onlyMatch("", // wrong inner SrcIP
+		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv4},
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ip42, DstIP: ip41},
+		&layers.UDP{SrcPort: 65000, DstPort: 2152},
+		makeGtpHdr(0x10000002, 1),
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ipI0, DstIP: ipI1},
+		&layers.UDP{SrcPort: 6363, DstPort: 6363},
+	)
+	onlyMatch("", // wrong inner DstPort
+		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv4},
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ip42, DstIP: ip41},
+		&layers.UDP{SrcPort: 65000, DstPort: 2152},
+		makeGtpHdr(0x10000002, 1),
+		&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: ipI2, DstIP: ipI1},
+		&layers.UDP{SrcPort: 6363, DstPort: 16363},
+	)
+
 	onlyMatch("vxlan0",
 		&layers.Ethernet{SrcMAC: mac2, DstMAC: mac1, EthernetType: layers.EthernetTypeIPv6},
 		&layers.IPv6{Version: 6, NextHeader: layers.IPProtocolUDP, SrcIP: ip62, DstIP: ip61},
@@ -413,4 +508,27 @@ func TestLocatorTxHdr(t *testing.T) {
 	vxlanUDP := vxlanPkt.Layer(layers.LayerTypeUDP).(*layers.UDP)
 	assert.GreaterOrEqual(uint16(vxlanUDP.SrcPort), uint16(0xC000))
 	assert.EqualValues(4789, vxlanUDP.DstPort)
+
+	gtpPkt := checkTxHdr(`{
+		"scheme": "gtp",
+		"local": "02:00:00:00:00:01",
+		"remote": "02:00:00:00:00:02",
+		"localIP": "192.168.37.1",
+		"remoteIP": "192.168.37.2",
+		"ulTEID": 268435457,
+		"ulQFI": 1,
+		"dlTEID": 536870913,
+		"dlQFI": 2,
+		"innerLocalIP": "10.0.0.1",
+		"innerRemoteIP": "10.0.0.2"
+	}`, layers.LayerTypeEthernet, layers.LayerTypeIPv4, layers.LayerTypeUDP, layers.LayerTypeGTPv1U, layers.LayerTypeIPv4, layers.LayerTypeUDP)
+	gtpGTP := gtpPkt.Layer(layers.LayerTypeGTPv1U).(*layers.GTPv1U)
+	assert.EqualValues(0x20000001, gtpGTP.TEID)
+	if assert.Len(gtpGTP.GTPExtensionHeaders, 1) {
+		assert.EqualValues(0x85, gtpGTP.GTPExtensionHeaders[0].Type)
+		assert.Equal([]byte{0x00, 0x02}, gtpGTP.GTPExtensionHeaders[0].Content)
+	}
+	gtpInnerIP := gtpPkt.Layers()[4].(*layers.IPv4)
+	assert.Equal(net.ParseIP("10.0.0.1").To4(), gtpInnerIP.SrcIP)
+	assert.Equal(net.ParseIP("10.0.0.2").To4(), gtpInnerIP.DstIP)
 }
@@ -87,7 +87,15 @@ func testPortTAP(t testing.TB, makeNetifConfig func(ifname string) ethnetif.Conf
 	locVX.InnerRemote.Set("02:00:00:00:01:02")
 	faceVX := addFace(locVX)
 
-	var txEther, txUDP4, txUDP4p1, txUDP6, txVX, txOther atomic.Int32
+	var locGTP ethface.GtpLocator
+	locGTP.EtherLocator, locGTP.IPLocator = locUDP4.EtherLocator, locUDP4.IPLocator
+	locGTP.UlTEID, locGTP.UlQFI = 0x10000001, 1
+	locGTP.DlTEID, locGTP.DlQFI = 0x20000001, 2
+	locGTP.InnerLocalIP = netip.MustParseAddr("10.0.0.1")
+	locGTP.InnerRemoteIP = netip.MustParseAddr("10.0.0.2")
+	faceGTP := addFace(locGTP)
+
+	var txEther, txUDP4, txUDP4p1, txUDP6, txVX, txGTP, txOther atomic.Int32
 	go func() {
 		buf := make([]byte, port.EthDev().MTU())
 		for {
@@ -107,6 +115,9 @@ func testPortTAP(t testing.TB, makeNetifConfig func(ifname string) ethnetif.Conf
 				case *layers.IPv4:
 					isV4 = true
 				case *layers.UDP:
+					if classify == &txGTP { // inner UDP header in GTP-U
+						continue
+					}
 					if int(l.SrcPort) == locUDP4p1.LocalUDP {
 						classify = &txUDP4p1
 					} else if isV4 {
@@ -116,6 +127,8 @@ func testPortTAP(t testing.TB, makeNetifConfig func(ifname string) ethnetif.Conf
 					}
 				case *layers.VXLAN:
 					classify = &txVX
+				case *layers.GTPv1U:
+					classify = &txGTP
 				}
 			}
 			classify.Add(1)
@@ -180,6 +193,19 @@ func testPortTAP(t testing.TB, makeNetifConfig func(ifname string) ethnetif.Conf
 		)
 		assert.NoError(e)
 		iface.TxBurst(faceVX.ID(), makeTxBurst("VX", i))
+
+		_, e = writeToFromLayers(tap,
+			&layers.Ethernet{SrcMAC: locGTP.Remote.HardwareAddr, DstMAC: locGTP.Local.HardwareAddr, EthernetType: layers.EthernetTypeDot1Q},
+			&layers.Dot1Q{VLANIdentifier: uint16(locGTP.VLAN), Type: layers.EthernetTypeIPv4},
+			&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.IP(locGTP.RemoteIP.AsSlice()), DstIP: net.IP(locGTP.LocalIP.AsSlice())},
+			&layers.UDP{SrcPort: layers.UDPPort(65535 - i), DstPort: 2152},
+			makeGtpHdr(uint32(locGTP.UlTEID), 1),
+			&layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.IP(locGTP.InnerRemoteIP.AsSlice()), DstIP: net.IP(locGTP.InnerLocalIP.AsSlice())},
+			&layers.UDP{SrcPort: 6363, DstPort: 6363},
+			makeRxFrame("GTP", i),
+		)
+		assert.NoError(e)
+		iface.TxBurst(faceGTP.ID(), makeTxBurst("GTP", i))
 	}
 
 	time.Sleep(10 * time.Millisecond)
@@ -189,12 +215,14 @@ func testPortTAP(t testing.TB, makeNetifConfig func(ifname string) ethnetif.Conf
 	assert.EqualValues(500, faceUDP4p1.Counters().RxInterests)
 	assert.EqualValues(500, faceUDP6.Counters().RxInterests)
 	assert.EqualValues(500, faceVX.Counters().RxInterests)
+	assert.EqualValues(500, faceGTP.Counters().RxInterests)
 
 	assert.EqualValues(500, txEther.Load())
 	assert.EqualValues(500, txUDP4.Load())
 	assert.EqualValues(500, txUDP4p1.Load())
 	assert.EqualValues(500, txUDP6.Load())
 	assert.EqualValues(500, txVX.Load())
+	assert.EqualValues(500, txGTP.Load())
 	assert.Less(int(txOther.Load()), 50)
 }
 
@@ -1,6 +1,7 @@
 package ethface_test
 
 import (
+	"encoding/binary"
 	"io"
 	"sync"
 	"testing"
@@ -123,3 +124,11 @@ func pktmbufFromLayers(hdrs ...gopacket.SerializableLayer) *pktmbuf.Packet {
 	defer discard()
 	return makePacket(mbuftestenv.Headroom(0), b)
 }
+
+// makeGtpHdr creates a GTP-U header with PDU session container.
+// Its length field is not filled, because NDN-DPDK does not check it on received packets.
+func makeGtpHdr(teid uint32, pduType uint8) gopacket.Payload {
+	hdr := gopacket.Payload{0x34, 0xFF, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x85, 0x01, pduType << 4, 0x00, 0x00}
+	binary.BigEndian.PutUint32(hdr[4:], teid)
+	return hdr
+}
@@ -46,6 +46,17 @@ type FaceConfig struct {
 	// DisableTxChecksumOffload disables the usage of IPv4 and UDP checksum offloads.
 	DisableTxChecksumOffload bool `json:"disableTxChecksumOffload,omitempty"`
 
+	// TxQueue assigns the face to a specific ethdev TX queue, as 0-based index within txQueues of the Port.
+	//
+	// If omitted, the TX queue that has the fewest faces is chosen.
+	TxQueue *int `json:"txQueue,omitempty"`
+
+	// TxLCore assigns the TX queue of the face to the TxLoop running on the specified lcore.
+	// If the TX queue is already served by a TxLoop because it has other faces, they must match.
+	//
+	// If omitted, the first face on a TX queue selects a TxLoop via iface.ActivateTxFace.
+	TxLCore *int `json:"txLCore,omitempty"`
+
 	// privFaceConfig is hidden from JSON output.
 	privFaceConfig *FaceConfig
 }
@@ -92,6 +103,7 @@ type Face struct {
 
 	flow *C.struct_rte_flow
 	rxf  []*rxgFlow
+	txq  *txQueue
 }
 
 // NewFace creates a face on the given port.
@@ -143,13 +155,18 @@ func NewFace(port *Port, loc Locator) (iface.Face, error) {
 			defer face.port.mutex.Unlock()
 
 			id := face.ID()
+			txq, txl, e := face.port.chooseTxQueue(face.loc.EthFaceConfig())
+			if e != nil {
+				face.logger.Error("face start error; change Port config or locator, and try again", zap.Error(e))
+				return e
+			}
 			if e := face.port.rxImpl.Start(face); e != nil {
 				face.logger.Error("face start error; change Port config or locator, and try again", zap.Error(e))
 				return e
 			}
 			ethnetif.XDPInsertFaceMapEntry(face.port.dev, face.loc.EthCLocator().toXDP(), 0)
 
-			face.port.activateTx(face)
+			face.port.activateTx(face, txq, txl)
 			face.logger.Info("face started")
 			face.port.faces[id] = face
 			return nil
@@ -4,6 +4,7 @@ import (
 	"github.com/graphql-go/graphql"
 	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
 	"github.com/usnistgov/ndn-dpdk/core/jsonhelper"
+	"github.com/usnistgov/ndn-dpdk/dpdk/ealthread"
 	"github.com/usnistgov/ndn-dpdk/dpdk/ethdev"
 	"github.com/usnistgov/ndn-dpdk/dpdk/ethdev/ethnetif"
 	"github.com/usnistgov/ndn-dpdk/iface"
@@ -14,6 +15,7 @@ var (
 	GqlRxGroupInterface *gqlserver.Interface
 	GqlRxgFlowType      *graphql.Object
 	GqlRxgTableType     *graphql.Object
+	GqlTxQueueType      *graphql.Object
 )
 
 func gqlDefineRxGroup[T iface.RxGroup](oc graphql.ObjectConfig) *graphql.Object {
@@ -75,6 +77,49 @@ func init() {
 		},
 	})
 
+	GqlTxQueueType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "EthTxQueue",
+		Description: "Ethernet device TX queue.",
+		Fields: graphql.Fields{
+			"port": &graphql.Field{
+				Type: graphql.NewNonNull(ethdev.GqlEthDevType.Object),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					txq := p.Source.(*txQueue)
+					return txq.port.EthDev(), nil
+				},
+			},
+			"queue": &graphql.Field{
+				Type: gqlserver.NonNullInt,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					txq := p.Source.(*txQueue)
+					return txq.queue, nil
+				},
+			},
+			"txLoop": &graphql.Field{
+				Type:        ealthread.GqlWorkerType.Object,
+				Description: "TxLoop serving this TX queue.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					txq := p.Source.(*txQueue)
+					txq.port.mutex.Lock()
+					txl := txq.txl
+					txq.port.mutex.Unlock()
+					if txl == nil {
+						return nil, nil
+					}
+					return gqlserver.Optional(txl.LCore()), nil
+				},
+			},
+			"faces": &graphql.Field{
+				Type:        gqlserver.NewListNonNullElem(iface.GqlFaceType.Object),
+				Description: "Faces assigned to this TX queue.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					txq := p.Source.(*txQueue)
+					return txq.Faces(), nil
+				},
+			},
+		},
+	})
+
 	ethdev.GqlEthDevType.Object.AddFieldConfig("rxGroups", &graphql.Field{
 		Description: "RxGroups on Ethernet device.",
 		Type:        gqlserver.NewListNonNullElem(GqlRxGroupInterface.Interface),
@@ -98,6 +143,18 @@ func init() {
 		},
 	})
 
+	ethdev.GqlEthDevType.Object.AddFieldConfig("txQueues", &graphql.Field{
+		Description: "TX queues on Ethernet device.",
+		Type:        gqlserver.NewListNonNullElem(GqlTxQueueType),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			port := Find(p.Source.(ethdev.EthDev))
+			if port == nil {
+				return nil, nil
+			}
+			return port.txQueues(), nil
+		},
+	})
+
 	iface.GqlFaceType.Object.AddFieldConfig("ethDev", &graphql.Field{
 		Description: "Ethernet device containing this face.",
 		Type:        ethdev.GqlEthDevType.Object,
@@ -109,6 +166,22 @@ func init() {
 			return face.port.dev, nil
 		},
 	})
+	iface.GqlFaceType.Object.AddFieldConfig("ethTxQueue", &graphql.Field{
+		Description: "Ethernet device TX queue used by this face.",
+		Type:        GqlTxQueueType,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			face, ok := p.Source.(*Face)
+			if !ok {
+				return nil, nil
+			}
+			face.port.mutex.Lock()
+			defer face.port.mutex.Unlock()
+			if face.txq == nil {
+				return nil, nil
+			}
+			return face.txq, nil
+		},
+	})
 
 	gqlserver.AddMutation(&graphql.Field{
 		Name:        "createEthPort",
@@ -12,8 +12,14 @@ import (
 	"github.com/usnistgov/ndn-dpdk/iface"
 )
 
-// UDPPortVXLAN is the default UDP destination port for VXLAN.
-const UDPPortVXLAN = C.RTE_VXLAN_DEFAULT_PORT
+// UDP port numbers for tunnel protocols.
+const (
+	// UDPPortVXLAN is the default UDP destination port for VXLAN.
+	UDPPortVXLAN = C.RTE_VXLAN_DEFAULT_PORT
+
+	// UDPPortGTP is the UDP port for GTP-U.
+	UDPPortGTP = C.RTE_GTPU_UDP_PORT
+)
 
 func (loc *CLocator) ptr() *C.EthLocator {
 	return (*C.EthLocator)(unsafe.Pointer(loc))
@@ -81,7 +87,7 @@ func (hdr TxHdr) copyToC(c *C.EthTxHdr) {
 	*c = *(*C.EthTxHdr)(&hdr)
 }
 
-// IPLen returns the total length of IP, UDP, and VXLAN headers.
+// IPLen returns the total length of IP, UDP, and tunnel headers.
 func (hdr TxHdr) IPLen() int {
 	return int(hdr.len - hdr.l2len)
 }
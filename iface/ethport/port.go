@@ -46,6 +46,8 @@ type Config struct {
 	MTU int `json:"mtu,omitempty" gqldesc:"Change interface MTU (excluding Ethernet/VLAN headers)."`
 
 	RxFlowQueues int `json:"rxFlowQueues,omitempty" gqldesc:"Enable RxFlow and set maximum queue count."`
+
+	TxQueues int `json:"txQueues,omitempty" gqldesc:"Number of hardware TX queues, each served by one TxLoop."`
 }
 
 // ensureEthDev creates EthDev if it's not set.
@@ -71,6 +73,9 @@ func (cfg *Config) applyDefaults() {
 	if cfg.TxQueueSize == 0 {
 		cfg.TxQueueSize = DefaultTxQueueSize
 	}
+	if cfg.TxQueues <= 0 {
+		cfg.TxQueues = 1
+	}
 }
 
 // Port organizes EthFaces on an EthDev.
@@ -83,7 +88,7 @@ type Port struct {
 	faces        map[iface.ID]*Face
 	rxBouncePool *pktmbuf.Pool
 	rxImpl       rxImpl
-	txl          iface.TxLoop
+	txq          []*txQueue
 }
 
 // EthDev returns the Ethernet device.
@@ -159,28 +164,13 @@ func (port *Port) startDev(nRxQueues int, promisc bool) error {
 		Socket:   socket,
 		RxPool:   rxPool,
 	})
-	cfg.AddTxQueues(1, ethdev.TxQueueConfig{
+	cfg.AddTxQueues(port.cfg.TxQueues, ethdev.TxQueueConfig{
 		Capacity: port.cfg.TxQueueSize,
 		Socket:   socket,
 	})
 	return port.dev.Start(cfg)
 }
 
-func (port *Port) activateTx(face iface.Face) {
-	if port.txl == nil {
-		port.txl = iface.ActivateTxFace(face)
-	} else {
-		port.txl.Add(face)
-	}
-}
-
-func (port *Port) deactivateTx(face iface.Face) {
-	iface.DeactivateTxFace(face)
-	if len(port.faces) == 0 {
-		port.txl = nil
-	}
-}
-
 // New opens a Port.
 func New(cfg Config) (port *Port, e error) {
 	portsMutex.Lock()
@@ -196,6 +186,9 @@ func New(cfg Config) (port *Port, e error) {
 	if ndni.PacketMempool.Config().Dataroom < pktmbuf.DefaultHeadroom+cfg.MTU {
 		return nil, errors.New("PacketMempool dataroom is too small for requested MTU")
 	}
+	if maxTxQueues := int(cfg.EthDev.DevInfo().Max_tx_queues); maxTxQueues > 0 && cfg.TxQueues > maxTxQueues {
+		return nil, fmt.Errorf("%d TX queues requested but only %d allowed by driver", cfg.TxQueues, maxTxQueues)
+	}
 
 	port = &Port{
 		cfg:     cfg,
@@ -204,6 +197,13 @@ func New(cfg Config) (port *Port, e error) {
 		devInfo: cfg.EthDev.DevInfo(),
 		faces:   map[iface.ID]*Face{},
 	}
+	for queue := 0; queue < cfg.TxQueues; queue++ {
+		port.txq = append(port.txq, &txQueue{
+			port:  port,
+			queue: queue,
+			faces: map[iface.ID]*Face{},
+		})
+	}
 	switch port.devInfo.Driver() {
 	case ethdev.DriverXDP:
 		if port.rxBouncePool, e = pktmbuf.NewPool(pktmbuf.PoolConfig{
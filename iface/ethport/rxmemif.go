@@ -6,9 +6,11 @@ package ethport
 import "C"
 import (
 	"errors"
+	"fmt"
 
 	"github.com/usnistgov/ndn-dpdk/dpdk/ethdev"
 	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/zyedidia/generic"
 )
 
 type rxMemif struct{}
@@ -19,7 +21,9 @@ func (rxMemif) String() string {
 
 func (rxMemif) List(port *Port) (list []iface.RxGroup) {
 	for _, face := range port.faces {
-		list = append(list, face.rxf[0])
+		for _, rxf := range face.rxf {
+			list = append(list, rxf)
+		}
 	}
 	return
 }
@@ -32,20 +36,27 @@ func (impl *rxMemif) Init(port *Port) error {
 }
 
 func (impl *rxMemif) Start(face *Face) error {
-	if e := face.port.startDev(1, false); e != nil {
+	nQueues := generic.Max(1, face.loc.EthFaceConfig().NRxQueues)
+	if nQueues > iface.MaxFaceRxThreads {
+		return fmt.Errorf("number of RX queues cannot exceed %d", iface.MaxFaceRxThreads)
+	}
+	if e := face.port.startDev(nQueues, false); e != nil {
 		return e
 	}
 
 	cLoc := face.loc.EthCLocator()
-	C.EthFace_SetupRxMemif(face.priv, cLoc.ptr())
+	C.EthFace_SetupRxMemif(face.priv, cLoc.ptr(), C.int(nQueues))
 
-	rxf := &rxgFlow{
-		face:  face,
-		index: 0,
+	face.rxf = make([]*rxgFlow, nQueues)
+	for i := range face.rxf {
+		rxf := &rxgFlow{
+			face:  face,
+			index: i,
+			queue: uint16(i),
+		}
+		face.rxf[i] = rxf
+		iface.ActivateRxGroup(rxf)
 	}
-	face.rxf = []*rxgFlow{rxf}
-
-	iface.ActivateRxGroup(rxf)
 	return nil
 }
 
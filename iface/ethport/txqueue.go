@@ -0,0 +1,115 @@
+package ethport
+
+/*
+#include "../../csrc/ethface/face.h"
+*/
+import "C"
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+	"github.com/usnistgov/ndn-dpdk/iface"
+)
+
+// txQueue represents an ethdev TX queue.
+// All faces assigned to the same TX queue are served by the same TxLoop, because DPDK PMDs
+// generally do not permit concurrent transmission on the same queue.
+type txQueue struct {
+	port  *Port
+	queue int
+	txl   iface.TxLoop
+	faces map[iface.ID]*Face
+}
+
+// Faces returns faces assigned to this TX queue.
+func (txq *txQueue) Faces() (list []iface.Face) {
+	txq.port.mutex.Lock()
+	defer txq.port.mutex.Unlock()
+	for _, face := range txq.faces {
+		list = append(list, face)
+	}
+	slices.SortFunc(list, func(a, b iface.Face) bool { return a.ID() < b.ID() })
+	return list
+}
+
+// chooseTxQueue selects a TX queue for the face.
+// If cfg.TxQueue is set, that TX queue is used; otherwise, the TX queue with fewest faces is chosen.
+// If cfg.TxLCore is set, txl is the requested TxLoop, and the TX queue must be either unused or served by txl.
+// port.mutex must be held.
+func (port *Port) chooseTxQueue(cfg FaceConfig) (txq *txQueue, txl iface.TxLoop, e error) {
+	if cfg.TxLCore != nil {
+		if txl = iface.TxLoopByLCore(eal.LCoreFromID(*cfg.TxLCore)); txl == nil {
+			return nil, nil, fmt.Errorf("no TxLoop on lcore %d", *cfg.TxLCore)
+		}
+	}
+	compatible := func(q *txQueue) bool {
+		return txl == nil || q.txl == nil || q.txl == txl
+	}
+
+	if cfg.TxQueue != nil {
+		queue := *cfg.TxQueue
+		if queue < 0 || queue >= len(port.txq) {
+			return nil, nil, fmt.Errorf("TX queue %d does not exist; Port has %d TX queues", queue, len(port.txq))
+		}
+		if txq = port.txq[queue]; !compatible(txq) {
+			return nil, nil, fmt.Errorf("TX queue %d is served by TxLoop on lcore %d", queue, txq.txl.LCore().ID())
+		}
+		return txq, txl, nil
+	}
+
+	// when TxLoop is requested, prefer TX queues already served by that TxLoop over unused TX queues
+	score := func(q *txQueue) int {
+		if txl != nil && q.txl == nil {
+			return len(port.faces) + len(q.faces)
+		}
+		return len(q.faces)
+	}
+	for _, q := range port.txq {
+		if compatible(q) && (txq == nil || score(q) < score(txq)) {
+			txq = q
+		}
+	}
+	if txq == nil {
+		return nil, nil, fmt.Errorf("no TX queue available for TxLoop on lcore %d", *cfg.TxLCore)
+	}
+	return txq, txl, nil
+}
+
+// activateTx assigns a TX queue to the face and adds the face to a TxLoop.
+// The first face on a TX queue chooses a TxLoop, either txl if specified or via iface.ActivateTxFace;
+// subsequent faces on the same TX queue join that TxLoop.
+// port.mutex must be held.
+func (port *Port) activateTx(face *Face, txq *txQueue, txl iface.TxLoop) {
+	face.txq = txq
+	face.priv.txQueue = C.uint16_t(txq.queue)
+
+	switch {
+	case txq.txl != nil:
+		txq.txl.Add(face)
+	case txl != nil:
+		txl.Add(face)
+		txq.txl = txl
+	default:
+		txq.txl = iface.ActivateTxFace(face)
+	}
+	txq.faces[face.ID()] = face
+}
+
+// deactivateTx removes the face from its TxLoop.
+// port.mutex must be held.
+func (port *Port) deactivateTx(face *Face) {
+	txq := face.txq
+	iface.DeactivateTxFace(face)
+	delete(txq.faces, face.ID())
+	if len(txq.faces) == 0 {
+		txq.txl = nil
+	}
+	face.txq = nil
+}
+
+// txQueues returns a list of TX queues.
+func (port *Port) txQueues() []*txQueue {
+	return port.txq
+}
@@ -1,18 +1,21 @@
 package iface
 
 import (
+	"time"
+
 	"github.com/usnistgov/ndn-dpdk/core/events"
 )
 
 var emitter = events.NewEmitter()
 
 const (
-	evtFaceNew     = "FaceNew"
-	evtFaceUp      = "FaceUp"
-	evtFaceDown    = "FaceDown"
-	evtFaceClosing = "FaceClosing"
-	evtFaceClosed  = "FaceClosed"
-	evtCloseAll    = "CloseAll"
+	evtFaceNew      = "FaceNew"
+	evtFaceUp       = "FaceUp"
+	evtFaceDown     = "FaceDown"
+	evtFaceClosing  = "FaceClosing"
+	evtFaceClosed   = "FaceClosed"
+	evtFaceLiveness = "FaceLiveness"
+	evtCloseAll     = "CloseAll"
 )
 
 // OnFaceNew registers a callback when a new face is created.
@@ -45,8 +48,63 @@ func OnFaceClosed(cb func(ID)) (cancel func()) {
 	return emitter.On(evtFaceClosed, cb)
 }
 
+// OnFaceLiveness registers a callback when liveness probing detects a face has become dead or alive.
+// Return a function that cancels the callback registration.
+func OnFaceLiveness(cb func(id ID, alive bool)) (cancel func()) {
+	return emitter.On(evtFaceLiveness, cb)
+}
+
 // OnCloseAll registers a callback when CloseAll() is requested.
 // Return a function that cancels the callback registration.
 func OnCloseAll(cb func()) (cancel func()) {
 	return emitter.On(evtCloseAll, cb)
 }
+
+// FaceEventKind indicates the kind of a face event.
+type FaceEventKind string
+
+// FaceEventKind values.
+const (
+	FaceEventCreated FaceEventKind = "CREATED"
+	FaceEventUp      FaceEventKind = "UP"
+	FaceEventDown    FaceEventKind = "DOWN"
+	FaceEventClosing FaceEventKind = "CLOSING"
+)
+
+// FaceEvent describes a face event.
+type FaceEvent struct {
+	Kind      FaceEventKind   `json:"kind" gqldesc:"Event kind."`
+	ID        ID              `json:"nid" gqldesc:"Numeric face identifier."`
+	Locator   *LocatorWrapper `json:"locator,omitempty" gqldesc:"Endpoint addresses."`
+	Timestamp time.Time       `json:"timestamp" gqldesc:"When the event occurred."`
+}
+
+// OnFaceEvent registers a callback on face creation, UP/DOWN state changes, and face closing.
+// Return a function that cancels the callback registration.
+func OnFaceEvent(cb func(evt FaceEvent)) (cancel func()) {
+	makeCb := func(kind FaceEventKind) func(id ID) {
+		return func(id ID) {
+			evt := FaceEvent{
+				Kind:      kind,
+				ID:        id,
+				Timestamp: time.Now(),
+			}
+			if face := Get(id); face != nil {
+				evt.Locator = &LocatorWrapper{face.Locator()}
+			}
+			cb(evt)
+		}
+	}
+
+	cancels := []func(){
+		OnFaceNew(makeCb(FaceEventCreated)),
+		OnFaceUp(makeCb(FaceEventUp)),
+		OnFaceDown(makeCb(FaceEventDown)),
+		OnFaceClosing(makeCb(FaceEventClosing)),
+	}
+	return func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
@@ -8,6 +8,7 @@ import "C"
 import (
 	"fmt"
 	"io"
+	"sync"
 	"unsafe"
 
 	"github.com/usnistgov/ndn-dpdk/core/cptr"
@@ -18,6 +19,7 @@ import (
 	"github.com/zyedidia/generic"
 	"go.uber.org/zap"
 	"go4.org/must"
+	"golang.org/x/exp/slices"
 )
 
 var logger = logging.New("iface")
@@ -43,6 +45,12 @@ type Face interface {
 	// ExCounters returns extended counters.
 	ExCounters() any
 
+	// TxLatency returns TX latency statistics.
+	TxLatency() TxLatency
+
+	// ResetTxLatency clears TX latency statistics.
+	ResetTxLatency()
+
 	// TxAlign returns TX packet alignment requirement.
 	TxAlign() ndni.PacketTxAlign
 
@@ -51,7 +59,11 @@ type Face interface {
 	EnableInputDemuxes()
 
 	// SetDown changes face UP/DOWN state.
+	// The face remains DOWN while liveness probing considers it lost, even if isDown is false.
 	SetDown(isDown bool)
+
+	// IsLocal returns true if the face is local.
+	IsLocal() bool
 }
 
 // Config contains face configuration.
@@ -76,6 +88,27 @@ type Config struct {
 	// If this is less than MinMTU or greater than the maximum, the face will fail to initialize.
 	MTU int `json:"mtu,omitempty"`
 
+	// Local indicates whether the face is local, i.e. connected to applications on the same host.
+	// Packets under /localhost prefix can only be exchanged over local faces.
+	// Interests under /localhop prefix received from a non-local face can only be forwarded to local faces.
+	//
+	// Default depends on the face type: memif and Unix socket faces are local, other faces are non-local.
+	Local *bool `json:"isLocal,omitempty"`
+
+	// TxClasses enables TX priority classes.
+	// If nil, the face has a single packet queue before the output thread, sized by OutputQueueSize.
+	// Otherwise, OutputQueueSize is ignored.
+	TxClasses *TxClassConfig `json:"txClasses,omitempty"`
+
+	// LatencySampleInterval is the sample interval of latency statistics.
+	// It is adjusted to nearest power of two.
+	// Default is DefaultLatencySampleInterval.
+	LatencySampleInterval int `json:"latencySampleInterval,omitempty"`
+
+	// Liveness enables liveness probing.
+	// If nil, the face does not transmit IDLE packets and its UP/DOWN state does not depend on RX activity.
+	Liveness *LivenessConfig `json:"liveness,omitempty"`
+
 	maxMTU int
 }
 
@@ -87,6 +120,23 @@ func (c *Config) ApplyDefaults() {
 	c.ReassemblerCapacity = generic.Clamp(c.ReassemblerCapacity, MinReassemblerCapacity, MaxReassemblerCapacity)
 
 	c.OutputQueueSize = ringbuffer.AlignCapacity(c.OutputQueueSize, MinOutputQueueSize, DefaultOutputQueueSize)
+
+	if c.LatencySampleInterval <= 0 {
+		c.LatencySampleInterval = DefaultLatencySampleInterval
+	}
+
+	if c.TxClasses != nil {
+		txc := *c.TxClasses
+		txc.Classes = slices.Clone(txc.Classes)
+		txc.applyDefaults()
+		c.TxClasses = &txc
+	}
+
+	if c.Liveness != nil {
+		liveness := *c.Liveness
+		liveness.applyDefaults()
+		c.Liveness = &liveness
+	}
 }
 
 // WithMaxMTU returns a copy of Config with consideration of device MTU.
@@ -95,6 +145,14 @@ func (c Config) WithMaxMTU(max int) Config {
 	return c
 }
 
+// WithLocalDefault returns a copy of Config with Local set to local if it is unspecified.
+func (c Config) WithLocalDefault(local bool) Config {
+	if c.Local == nil {
+		c.Local = &local
+	}
+	return c
+}
+
 func (c *Config) checkMTU() error {
 	if c.maxMTU == 0 {
 		c.maxMTU = MaxMTU
@@ -165,6 +223,11 @@ func New(p NewParams) (face Face, e error) {
 	if e = p.Config.checkMTU(); e != nil {
 		return nil, e
 	}
+	if p.TxClasses != nil {
+		if e = p.TxClasses.validate(); e != nil {
+			return nil, e
+		}
+	}
 	if p.Socket.IsAny() {
 		p.Socket = eal.RandomSocket()
 	}
@@ -193,6 +256,7 @@ func newFace(p NewParams) (Face, error) {
 	c := f.ptr()
 	c.id = C.FaceID(f.id)
 	c.state = StateUp
+	c.isLocal = C.bool(p.Local != nil && *p.Local)
 	c.impl = eal.ZmallocAligned[C.FaceImpl]("FaceImpl", C.sizeof_FaceImpl+p.SizeofPriv, 1, p.Socket)
 
 	initResult, e := p.Init(f)
@@ -206,6 +270,11 @@ func newFace(p NewParams) (Face, error) {
 	logEntry = logEntry.With(zap.Reflect("locator", LocatorWrapper{f.Locator()}))
 
 	c.impl.rxParseFor = C.ParseFor(RxParseFor)
+	c.impl.txResidenceStat = C.bool(TxResidenceStat)
+	f.initTxLatency(p.LatencySampleInterval)
+	if p.Liveness != nil {
+		c.impl.txIdleInterval = C.TscDuration(eal.ToTscDuration(p.Liveness.Interval.Duration()))
+	}
 
 	c.txAlign = C.PacketTxAlign{
 		linearize:           C.bool(initResult.TxLinearize),
@@ -214,12 +283,17 @@ func newFace(p NewParams) (Face, error) {
 	c.impl.txBurst = C.Face_TxBurstFunc(initResult.TxBurst)
 	(*ndni.Mempools)(unsafe.Pointer(&c.impl.txMempools)).Assign(p.Socket)
 
-	outputQueue, e := ringbuffer.New(p.OutputQueueSize, p.Socket, ringbuffer.ProducerMulti, ringbuffer.ConsumerSingle)
-	if e != nil {
-		logEntry.Warn("outputQueue error", zap.Error(e))
+	if p.TxClasses == nil {
+		outputQueue, e := ringbuffer.New(p.OutputQueueSize, p.Socket, ringbuffer.ProducerMulti, ringbuffer.ConsumerSingle)
+		if e != nil {
+			logEntry.Warn("outputQueue error", zap.Error(e))
+			return f.clear(), e
+		}
+		c.outputQueue = (*C.struct_rte_ring)(outputQueue.Ptr())
+	} else if e := setupTxClasses(c, *p.TxClasses, p.Socket); e != nil {
+		logEntry.Warn("setupTxClasses error", zap.Error(e))
 		return f.clear(), e
 	}
-	c.outputQueue = (*C.struct_rte_ring)(outputQueue.Ptr())
 
 	for i := 0; i < MaxFaceRxThreads; i++ {
 		reassID := C.CString(eal.AllocObjectID("iface.Reassembler"))
@@ -236,6 +310,9 @@ func newFace(p NewParams) (Face, error) {
 		logEntry.Warn("start error", zap.Error(e))
 		return f.clear(), e
 	}
+	if p.Liveness != nil {
+		f.startLiveness(*p.Liveness)
+	}
 
 	gFaces[f.id] = initResult.Face
 	emitter.Emit(evtFaceNew, f.id)
@@ -250,6 +327,11 @@ type face struct {
 	stopCallback       func() error
 	closeCallback      func() error
 	exCountersCallback func() any
+	liveness           *livenessMonitor
+
+	downMutex    sync.Mutex
+	requestDown  bool // DOWN requested via SetDown, e.g. transport is down
+	livenessLost bool // DOWN due to liveness probing
 }
 
 func (f *face) ptr() *C.Face {
@@ -281,6 +363,9 @@ func (f *face) close() error {
 	f.ptr().state = StateDown
 	emitter.Emit(evtFaceClosing, f.id)
 
+	if f.liveness != nil {
+		f.liveness.close()
+	}
 	if e := f.stopCallback(); e != nil {
 		return e
 	}
@@ -298,6 +383,7 @@ func (f *face) clear() Face {
 	id, c := f.id, f.ptr()
 	c.state = StateRemoved
 	if c.impl != nil {
+		clearTxClasses(c)
 		for i := 0; i < MaxFaceRxThreads; i++ {
 			C.Reassembler_Close(&c.impl.rx[i].reass)
 		}
@@ -344,7 +430,23 @@ func (f *face) EnableInputDemuxes() {
 }
 
 func (f *face) SetDown(isDown bool) {
-	id, c := f.id, f.ptr()
+	f.downMutex.Lock()
+	defer f.downMutex.Unlock()
+	f.requestDown = isDown
+	f.updateDown()
+}
+
+func (f *face) setLivenessLost(lost bool) {
+	f.downMutex.Lock()
+	defer f.downMutex.Unlock()
+	f.livenessLost = lost
+	f.updateDown()
+}
+
+// updateDown sets face UP/DOWN state from all DOWN reasons.
+// f.downMutex must be held.
+func (f *face) updateDown() {
+	id, c, isDown := f.id, f.ptr(), f.requestDown || f.livenessLost
 	switch {
 	case isDown && c.state == StateUp:
 		c.state = StateDown
@@ -356,6 +458,10 @@ func (f *face) SetDown(isDown bool) {
 	// don't change state if face is closing/removed
 }
 
+func (f *face) IsLocal() bool {
+	return bool(f.ptr().isLocal)
+}
+
 // IsDown returns true if the face does not exist or is down.
 func IsDown(id ID) bool {
 	return bool(C.Face_IsDown(C.FaceID(id)))
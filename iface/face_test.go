@@ -6,6 +6,9 @@ import (
 
 	"github.com/usnistgov/ndn-dpdk/iface"
 	"github.com/usnistgov/ndn-dpdk/iface/intface"
+	"github.com/usnistgov/ndn-dpdk/iface/socketface"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
 	"github.com/usnistgov/ndn-dpdk/ndni"
 	"github.com/usnistgov/ndn-dpdk/ndni/ndnitestenv"
 )
@@ -28,15 +31,119 @@ func TestTxBurst(t *testing.T) {
 	iface.TxBurst(id, pkts)
 	time.Sleep(100 * time.Millisecond)
 
+	lat := face.D.TxLatency()
+	assert.EqualValues(1, lat.Sojourn.Count)
+	assert.Nil(lat.Residence)
+
+	face.D.ResetTxLatency()
+	pkts = make([]*ndni.Packet, 2)
+	pkts[0] = ndnitestenv.MakeInterest("/B")
+	pkts[1] = ndnitestenv.MakeInterest("/C")
+	iface.TxBurst(id, pkts)
+	time.Sleep(100 * time.Millisecond)
+	assert.EqualValues(2, face.D.TxLatency().Sojourn.Count)
+
 	require.NoError(face.D.Close())
 	pkts = make([]*ndni.Packet, 1)
 	pkts[0] = ndnitestenv.MakeInterest("/A")
 	iface.TxBurst(id, pkts)
 
-	assert.Equal(1, collect.Count())
+	assert.Equal(3, collect.Count())
 	assert.NotNil(collect.Get(0).Data)
 }
 
+func TestTxClasses(t *testing.T) {
+	assert, require := makeAR(t)
+
+	var cfg socketface.Config
+	cfg.TxClasses = &iface.TxClassConfig{
+		Weighted: true,
+		Classes:  []iface.TxClass{{Weight: 4}, {Weight: 1}, {}},
+		Data:     1,
+		Rules: []iface.TxClassRule{
+			{Prefix: ndn.ParseName("/B"), Class: 2},
+			{Prefix: ndn.ParseName("/B/2"), Class: 0},
+		},
+	}
+	face := intface.Must(intface.New(cfg))
+	defer face.D.Close()
+	collect := intface.Collect(face)
+
+	iface.TxBurst(face.ID, []*ndni.Packet{
+		ndnitestenv.MakeInterest("/A"),
+		ndnitestenv.MakeData("/A"),
+		ndnitestenv.MakeInterest("/B/1"),
+		ndnitestenv.MakeData("/B/2"),
+		ndnitestenv.MakeNack(ndn.MakeInterest("/C"), an.NackCongestion),
+	})
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(5, collect.Count())
+
+	cnt := face.D.Counters()
+	require.Len(cnt.TxClasses, 3)
+	assert.EqualValues(3, cnt.TxClasses[0].TxPackets)
+	assert.EqualValues(1, cnt.TxClasses[1].TxPackets)
+	assert.EqualValues(1, cnt.TxClasses[2].TxPackets)
+	assert.EqualValues(0, cnt.TxClasses[0].TxDropped)
+
+	assert.Zero(cnt.TxQueueDropped)
+
+	cfg.TxClasses.Classes = make([]iface.TxClass, iface.MaxFaceTxClasses+1)
+	_, e := intface.New(cfg)
+	assert.Error(e)
+
+	cfg.TxClasses.Classes = make([]iface.TxClass, 1)
+	cfg.TxClasses.Data, cfg.TxClasses.Rules = 0, nil
+	_, e = intface.New(cfg)
+	assert.Error(e)
+}
+
+func TestLiveness(t *testing.T) {
+	assert, _ := makeAR(t)
+
+	livenessEvts := make(chan bool, 4)
+	var cfg socketface.Config
+	cfg.Liveness = &iface.LivenessConfig{
+		Interval:  50,
+		MissCount: 2,
+	}
+	face := intface.Must(intface.New(cfg))
+	defer face.D.Close()
+	defer iface.OnFaceLiveness(func(id iface.ID, alive bool) {
+		if id == face.ID {
+			livenessEvts <- alive
+		}
+	})()
+
+	go func() {
+		for range face.Rx {
+		}
+	}()
+
+	time.Sleep(300 * time.Millisecond)
+	assert.True(iface.IsDown(face.ID))
+	select {
+	case alive := <-livenessEvts:
+		assert.False(alive)
+	default:
+		assert.Fail("missing liveness event")
+	}
+	assert.Greater(face.D.Counters().TxIdle, uint64(0))
+
+	face.SetDown(false) // transport UP does not override liveness
+	assert.True(iface.IsDown(face.ID))
+
+	face.Tx <- ndn.MakeInterest("/A")
+	time.Sleep(100 * time.Millisecond)
+	assert.False(iface.IsDown(face.ID))
+	select {
+	case alive := <-livenessEvts:
+		assert.True(alive)
+	default:
+		assert.Fail("missing liveness event")
+	}
+}
+
 func TestEvents(t *testing.T) {
 	assert, require := makeAR(t)
 
@@ -45,6 +152,7 @@ func TestEvents(t *testing.T) {
 	var faceDownEvts []iface.ID
 	var faceClosingEvts []iface.ID
 	var faceClosedEvts []iface.ID
+	var faceEvts []iface.FaceEvent
 	defer iface.OnFaceNew(func(id iface.ID) {
 		faceNewEvts = append(faceNewEvts, id)
 	})()
@@ -63,6 +171,10 @@ func TestEvents(t *testing.T) {
 		assert.Equal(id, faceClosedEvts[len(faceClosingEvts)-1])
 	})()
 
+	defer iface.OnFaceEvent(func(evt iface.FaceEvent) {
+		faceEvts = append(faceEvts, evt)
+	})()
+
 	face1, face2 := intface.MustNew(), intface.MustNew()
 	id1, id2 := face1.ID, face2.ID
 	if assert.Len(faceNewEvts, 2) {
@@ -92,4 +204,18 @@ func TestEvents(t *testing.T) {
 		assert.Equal(id1, faceClosedEvts[1])
 	}
 	assert.True(iface.IsDown(id1))
+
+	if assert.Len(faceEvts, 6) {
+		kinds := []iface.FaceEventKind{}
+		for _, evt := range faceEvts {
+			kinds = append(kinds, evt.Kind)
+			assert.NotNil(evt.Locator)
+			assert.False(evt.Timestamp.IsZero())
+		}
+		assert.Equal([]iface.FaceEventKind{
+			iface.FaceEventCreated, iface.FaceEventCreated, iface.FaceEventDown, iface.FaceEventUp,
+			iface.FaceEventClosing, iface.FaceEventClosing,
+		}, kinds)
+		assert.Equal(id1, faceEvts[2].ID)
+	}
 }
@@ -3,11 +3,13 @@ package iface
 import (
 	"errors"
 	"reflect"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
 	"github.com/usnistgov/ndn-dpdk/core/jsonhelper"
 	"github.com/usnistgov/ndn-dpdk/core/nnduration"
+	"github.com/usnistgov/ndn-dpdk/core/runningstat"
 	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
 	"github.com/usnistgov/ndn-dpdk/dpdk/ealthread"
 )
@@ -21,12 +23,16 @@ var (
 
 // GraphQL types.
 var (
-	GqlPktQueueInput    *graphql.InputObject
-	GqlFaceType         *gqlserver.NodeType[Face]
-	GqlRxCountersType   *graphql.Object
-	GqlTxCountersType   *graphql.Object
-	GqlCountersType     *graphql.Object
-	GqlRxGroupInterface *gqlserver.Interface
+	GqlPktQueueInput       *graphql.InputObject
+	GqlFaceType            *gqlserver.NodeType[Face]
+	GqlRxCountersType      *graphql.Object
+	GqlTxCountersType      *graphql.Object
+	GqlTxClassCountersType *graphql.Object
+	GqlCountersType        *graphql.Object
+	GqlTxLatencyType       *graphql.Object
+	GqlFaceEventKindEnum   *graphql.Enum
+	GqlFaceEventType       *graphql.Object
+	GqlRxGroupInterface    *gqlserver.Interface
 )
 
 func init() {
@@ -69,6 +75,14 @@ func init() {
 					return IsDown(face.ID()), nil
 				},
 			},
+			"isLocal": &graphql.Field{
+				Type:        gqlserver.NonNullBoolean,
+				Description: "Whether the face is local, for /localhost and /localhop scope control.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					face := p.Source.(Face)
+					return face.IsLocal(), nil
+				},
+			},
 			"txLoop": &graphql.Field{
 				Type:        ealthread.GqlWorkerType.Object,
 				Description: "TxLoop serving this face.",
@@ -134,11 +148,16 @@ func init() {
 		Name:   "FaceTxCounters",
 		Fields: gqlserver.BindFields[TxCounters](nil),
 	})
+	GqlTxClassCountersType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "FaceTxClassCounters",
+		Fields: gqlserver.BindFields[TxClassCounters](nil),
+	})
 	GqlCountersType = graphql.NewObject(graphql.ObjectConfig{
 		Name: "FaceCounters",
 		Fields: gqlserver.BindFields[Counters](gqlserver.FieldTypes{
-			reflect.TypeOf(RxCounters{}): GqlRxCountersType,
-			reflect.TypeOf(TxCounters{}): GqlTxCountersType,
+			reflect.TypeOf(RxCounters{}):      GqlRxCountersType,
+			reflect.TypeOf(TxCounters{}):      GqlTxCountersType,
+			reflect.TypeOf(TxClassCounters{}): GqlTxClassCountersType,
 		}),
 	})
 	gqlserver.AddCounters(&gqlserver.CountersConfig{
@@ -163,6 +182,72 @@ func init() {
 		},
 	})
 
+	GqlTxLatencyType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "FaceTxLatency",
+		Fields: gqlserver.BindFields[TxLatency](gqlserver.FieldTypes{
+			reflect.TypeOf(runningstat.HistSnapshot{}): runningstat.GqlHistSnapshotType,
+		}),
+	})
+	GqlFaceType.Object.AddFieldConfig("txLatency", &graphql.Field{
+		Type:        graphql.NewNonNull(GqlTxLatencyType),
+		Description: "TX latency statistics since face creation or last reset.",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			face := p.Source.(Face)
+			return face.TxLatency(), nil
+		},
+	})
+
+	gqlserver.AddMutation(&graphql.Field{
+		Name:        "resetFaceTxLatency",
+		Description: "Reset TX latency statistics of a face, starting a new measurement window.",
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{
+				Type: gqlserver.NonNullID,
+			},
+		},
+		Type: graphql.NewNonNull(GqlFaceType.Object),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			face := GqlFaceType.Retrieve(p.Args["id"].(string))
+			if face == nil {
+				return nil, errors.New("face not found")
+			}
+			face.ResetTxLatency()
+			return face, nil
+		},
+	})
+
+	GqlFaceEventKindEnum = gqlserver.NewStringEnum("FaceEventKind", "Face event kind.",
+		FaceEventCreated, FaceEventUp, FaceEventDown, FaceEventClosing)
+	GqlFaceEventType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "FaceEvent",
+		Description: "Face event.",
+		Fields: gqlserver.BindFields[FaceEvent](gqlserver.FieldTypes{
+			reflect.TypeOf(FaceEventKind("")): GqlFaceEventKindEnum,
+			reflect.TypeOf(&LocatorWrapper{}): gqlserver.JSON,
+			reflect.TypeOf(time.Time{}):       graphql.DateTime,
+		}),
+	})
+	GqlFaceEventType.AddFieldConfig("face", &graphql.Field{
+		Type:        GqlFaceType.Object,
+		Description: "Face, if it still exists.",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			evt := p.Source.(FaceEvent)
+			if face := Get(evt.ID); face != nil {
+				return face, nil
+			}
+			return nil, nil
+		},
+	})
+
+	gqlserver.AddSubscription(&graphql.Field{
+		Name:        "faceEvents",
+		Description: "Face creation, UP/DOWN state changes, and face closing.",
+		Type:        graphql.NewNonNull(GqlFaceEventType),
+		Subscribe: func(p graphql.ResolveParams) (any, error) {
+			return gqlserver.PublishEvents(p, OnFaceEvent)
+		},
+	})
+
 	GqlRxGroupInterface = gqlserver.NewInterface(graphql.InterfaceConfig{
 		Name: "RxGroup",
 		Fields: graphql.Fields{
@@ -0,0 +1,62 @@
+package iface
+
+/*
+#include "../csrc/iface/face.h"
+*/
+import "C"
+import (
+	"unsafe"
+
+	"github.com/usnistgov/ndn-dpdk/core/runningstat"
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+)
+
+// DefaultLatencySampleInterval is the default sample interval of face latency statistics.
+const DefaultLatencySampleInterval = 16
+
+// TxResidenceStat indicates whether newly created faces collect dataplane residence time statistics.
+// This should be set only if the timestamp of every outgoing packet reflects its arrival time,
+// as is the case in the forwarder.
+var TxResidenceStat = false
+
+// TxLatency contains face TX latency statistics.
+type TxLatency struct {
+	Sojourn   runningstat.HistSnapshot  `json:"sojourn" gqldesc:"Time in before-Tx queue, in nanoseconds."`
+	Residence *runningstat.HistSnapshot `json:"residence" gqldesc:"Time since packet arrival until dequeued for transmission, in nanoseconds. Available on forwarder faces."`
+}
+
+func (f *face) txThread() *C.FaceTxThread {
+	return &f.ptr().impl.tx[0]
+}
+
+func (f *face) initTxLatency(sampleInterval int) {
+	txt := f.txThread()
+	runningstat.HistFromPtr(unsafe.Pointer(&txt.sojournStat)).Init(sampleInterval)
+	runningstat.HistFromPtr(unsafe.Pointer(&txt.residenceStat)).Init(sampleInterval)
+}
+
+// TxLatency retrieves TX latency statistics.
+func (f *face) TxLatency() (lat TxLatency) {
+	c := f.ptr()
+	if c.impl == nil {
+		return
+	}
+
+	txt := f.txThread()
+	lat.Sojourn = runningstat.HistFromPtr(unsafe.Pointer(&txt.sojournStat)).ReadSnapshot().Scale(eal.TscNanos)
+	if c.impl.txResidenceStat {
+		residence := runningstat.HistFromPtr(unsafe.Pointer(&txt.residenceStat)).ReadSnapshot().Scale(eal.TscNanos)
+		lat.Residence = &residence
+	}
+	return
+}
+
+// ResetTxLatency clears TX latency statistics, starting a new measurement window.
+// Statistics are cleared by the TX thread when it next processes this face.
+func (f *face) ResetTxLatency() {
+	c := f.ptr()
+	if c.impl == nil {
+		return
+	}
+	C.FaceTxThread_ClearLatency(f.txThread())
+}
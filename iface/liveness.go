@@ -0,0 +1,118 @@
+package iface
+
+import (
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/core/nnduration"
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+	"go.uber.org/zap"
+)
+
+// Liveness probing defaults.
+const (
+	DefaultLivenessInterval  = 1000
+	DefaultLivenessMissCount = 3
+)
+
+// LivenessConfig contains face liveness probing configuration.
+//
+// When enabled, the face transmits an NDNLPv2 IDLE packet if no other frame has been transmitted
+// during an interval, so that the peer can observe this face is alive.
+// Meanwhile, if no frame has been received from the peer during MissCount consecutive intervals,
+// the face is set to DOWN state; it returns to UP state when a frame is received again,
+// unless the face is also DOWN for another reason such as transport failure.
+// The peer must either enable liveness probing or otherwise transmit traffic periodically.
+type LivenessConfig struct {
+	// Interval is the probing interval in milliseconds.
+	// Default is DefaultLivenessInterval.
+	Interval nnduration.Milliseconds `json:"interval,omitempty"`
+
+	// MissCount is the number of consecutive intervals without RX frames before the face is considered DOWN.
+	// Default is DefaultLivenessMissCount.
+	MissCount int `json:"missCount,omitempty"`
+}
+
+func (c *LivenessConfig) applyDefaults() {
+	if c.Interval == 0 {
+		c.Interval = DefaultLivenessInterval
+	}
+	if c.MissCount <= 0 {
+		c.MissCount = DefaultLivenessMissCount
+	}
+}
+
+// livenessMonitor sets a face DOWN or UP according to RX activity.
+type livenessMonitor struct {
+	f         *face
+	missCount int
+	ticker    *time.Ticker
+	stop      chan struct{}
+	stopped   bool
+
+	lastOctets uint64
+	misses     int
+	lost       bool
+}
+
+func (f *face) startLiveness(cfg LivenessConfig) {
+	m := &livenessMonitor{
+		f:         f,
+		missCount: cfg.MissCount,
+		ticker:    time.NewTicker(cfg.Interval.Duration()),
+		stop:      make(chan struct{}),
+	}
+	f.liveness = m
+	go m.run()
+}
+
+func (m *livenessMonitor) run() {
+	defer m.ticker.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-m.ticker.C:
+			eal.CallMain(m.check)
+		}
+	}
+}
+
+// check is invoked on main thread.
+func (m *livenessMonitor) check() {
+	c := m.f.ptr()
+	if m.stopped || c.impl == nil {
+		return
+	}
+
+	octets := uint64(0)
+	for i := range c.impl.rx {
+		octets += uint64(c.impl.rx[i].nFrames[0])
+	}
+
+	if octets != m.lastOctets {
+		m.lastOctets, m.misses = octets, 0
+		if m.lost {
+			m.lost = false
+			logger.Info("face liveness restored", m.f.id.ZapField("id"))
+			m.f.setLivenessLost(false)
+			emitter.Emit(evtFaceLiveness, m.f.id, true)
+		}
+		return
+	}
+
+	if m.misses++; m.misses >= m.missCount && !m.lost {
+		m.lost = true
+		logger.Info("face liveness lost", m.f.id.ZapField("id"), zap.Int("misses", m.misses))
+		m.f.setLivenessLost(true)
+		emitter.Emit(evtFaceLiveness, m.f.id, false)
+	}
+}
+
+// close is invoked on main thread.
+func (m *livenessMonitor) close() {
+	if m.stopped {
+		return
+	}
+	m.stopped = true
+	close(m.stop)
+}
@@ -15,6 +15,10 @@ const schemeMemif = "memif"
 // Locator describes a memif face.
 type Locator struct {
 	memiftransport.Locator
+
+	// Local indicates whether the face is local.
+	// Default is true.
+	Local *bool `json:"isLocal,omitempty"`
 }
 
 var _ ethport.Locator = Locator{}
@@ -31,6 +35,9 @@ func (loc Locator) EthCLocator() (c ethport.CLocator) {
 
 // EthFaceConfig implements ethport.Locator interface.
 func (loc Locator) EthFaceConfig() (cfg ethport.FaceConfig) {
+	cfg.Local = loc.Local
+	cfg.Config = cfg.Config.WithLocalDefault(true)
+	cfg.NRxQueues = loc.NQueues
 	return
 }
 
@@ -46,9 +53,12 @@ func (loc Locator) CreateFace() (iface.Face, error) {
 		return nil, e
 	}
 
+	// A TX queue is created in every queue pair so that the memif driver offers NQueues pairs to the peer,
+	// but the face transmits on one of them, see ethport.FaceConfig.TxQueue.
 	port, e := ethport.New(ethport.Config{
 		EthDev:    dev,
 		MTU:       loc.Dataroom,
+		TxQueues:  loc.NQueues,
 		AutoClose: true,
 	})
 	if e != nil {
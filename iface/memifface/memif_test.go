@@ -48,3 +48,78 @@ func TestMemif(t *testing.T) {
 		assert.EqualValues(8000, st.Gid)
 	}))
 }
+
+func TestFragmentation(t *testing.T) {
+	_, require := makeAR(t)
+	fixture := ifacetestenv.NewFixture(t)
+	fixture.PayloadLen = 3000
+	fixture.DataFrames = 2
+	socketName := filepath.Join(t.TempDir(), "memif.sock")
+
+	var locA memifface.Locator
+	locA.SocketName = socketName
+	locA.ID = 2191
+	faceA, e := locA.CreateFace()
+	require.NoError(e)
+
+	var locB memifface.Locator
+	locB.SocketName = socketName
+	locB.ID = 2192
+	faceB, e := locB.CreateFace()
+	require.NoError(e)
+
+	// NDN-DPDK faceA fragments, NDNgo reassembles and fragments again, NDN-DPDK faceB reassembles.
+	require.NoError(memiftransport.ForkL3BridgeHelper(memiftransport.Locator{
+		Role:       memiftransport.RoleClient,
+		SocketName: socketName,
+		ID:         2191,
+	}, memiftransport.Locator{
+		Role:       memiftransport.RoleClient,
+		SocketName: socketName,
+		ID:         2192,
+	}, func() {
+		fixture.RunTest(faceA, faceB)
+		fixture.CheckCounters()
+	}))
+}
+
+func TestMultiQueue(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := ifacetestenv.NewFixture(t)
+	socketName := filepath.Join(t.TempDir(), "memif.sock")
+
+	// NDNgo supports multiple queue pairs only in server role, so that NDN-DPDK uses client role.
+	require.NoError(memiftransport.ForkBridgeHelper(memiftransport.Locator{
+		Role:       memiftransport.RoleServer,
+		SocketName: socketName,
+		ID:         3301,
+		NQueues:    4,
+	}, memiftransport.Locator{
+		Role:       memiftransport.RoleServer,
+		SocketName: socketName,
+		ID:         3302,
+		NQueues:    4,
+	}, func() {
+		var locA memifface.Locator
+		locA.Role = memiftransport.RoleClient
+		locA.SocketName = socketName
+		locA.ID = 3301
+		locA.NQueues = 4
+		faceA, e := locA.CreateFace()
+		require.NoError(e)
+
+		var locB memifface.Locator
+		locB.Role = memiftransport.RoleClient
+		locB.SocketName = socketName
+		locB.ID = 3302
+		locB.NQueues = 4
+		faceB, e := locB.CreateFace()
+		require.NoError(e)
+
+		fixture.RunTest(faceA, faceB)
+		fixture.CheckCounters()
+
+		// NDNgo bridge spreads packets across queues by name hash
+		assert.Greater(len(faceB.Counters().RxThreads), 1)
+	}))
+}
@@ -1,4 +1,4 @@
-// Package socketface implements UDP/TCP socket faces using Go net.Conn type.
+// Package socketface implements UDP/TCP/WebSocket socket faces using Go net.Conn type.
 package socketface
 
 /*
@@ -48,6 +48,7 @@ func New(loc Locator) (iface.Face, error) {
 // Wrap wraps a sockettransport.Transport to a socket face.
 func Wrap(transport sockettransport.Transport, cfg Config) (iface.Face, error) {
 	_, isUDP := transport.Conn().(*net.UDPConn)
+	_, isUnix := transport.Conn().(*net.UnixConn)
 	rxi := &rxConnsImpl
 	if isUDP && !gCfg.RxEpoll.Disabled {
 		rxi = &rxEpollImpl
@@ -61,7 +62,7 @@ func Wrap(transport sockettransport.Transport, cfg Config) (iface.Face, error) {
 		transport: transport,
 	}
 	return iface.New(iface.NewParams{
-		Config:     cfg.Config.WithMaxMTU(ndni.PacketMempool.Config().Dataroom - pktmbuf.DefaultHeadroom),
+		Config:     cfg.Config.WithLocalDefault(isUnix).WithMaxMTU(ndni.PacketMempool.Config().Dataroom - pktmbuf.DefaultHeadroom),
 		Socket:     gCfg.numaSocket(),
 		SizeofPriv: C.sizeof_SocketFacePriv,
 		Init: func(f iface.Face) (res iface.InitResult, e error) {
@@ -101,9 +102,12 @@ func Wrap(transport sockettransport.Transport, cfg Config) (iface.Face, error) {
 			laddr, raddr := conn.LocalAddr(), conn.RemoteAddr()
 
 			var loc Locator
-			loc.Network = raddr.Network()
-			loc.Remote = raddr.String()
+			if raddr != nil {
+				loc.Network = raddr.Network()
+				loc.Remote = raddr.String()
+			}
 			if laddr != nil {
+				loc.Network = laddr.Network()
 				loc.Local = laddr.String()
 			}
 			return loc
@@ -1,15 +1,21 @@
 package socketface
 
 import (
+	"errors"
+
 	"github.com/graphql-go/graphql"
 	"github.com/usnistgov/ndn-dpdk/core/gqlserver"
+	"github.com/usnistgov/ndn-dpdk/core/jsonhelper"
 	"github.com/usnistgov/ndn-dpdk/iface"
 )
 
+var errGqlCreateListenerDisallowed = errors.New("createSocketListener is disallowed; is NDN-DPDK forwarder activated?")
+
 // GraphQL types.
 var (
-	GqlRxConnsType *graphql.Object
-	GqlRxEpollType *graphql.Object
+	GqlRxConnsType  *graphql.Object
+	GqlRxEpollType  *graphql.Object
+	GqlListenerType *gqlserver.NodeType[*Listener]
 )
 
 func init() {
@@ -28,4 +34,88 @@ func init() {
 	iface.GqlRxGroupInterface.AppendTo(&ocRxEpoll)
 	GqlRxEpollType = graphql.NewObject(ocRxEpoll)
 	gqlserver.ImplementsInterface[*rxEpoll](GqlRxEpollType, iface.GqlRxGroupInterface)
+
+	GqlListenerType = gqlserver.NewNodeType(graphql.ObjectConfig{
+		Name:        "SocketListener",
+		Description: "Stream socket or WebSocket listener that creates a socket face per accepted connection.",
+		Fields: graphql.Fields{
+			"nid": &graphql.Field{
+				Description: "Listener ID.",
+				Type:        gqlserver.NonNullInt,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					l := p.Source.(*Listener)
+					return l.ID(), nil
+				},
+			},
+			"scheme": &graphql.Field{
+				Description: "Socket network type.",
+				Type:        gqlserver.NonNullString,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					l := p.Source.(*Listener)
+					return l.Addr().Network(), nil
+				},
+			},
+			"local": &graphql.Field{
+				Description: "Listening address.",
+				Type:        gqlserver.NonNullString,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					l := p.Source.(*Listener)
+					return l.Addr().String(), nil
+				},
+			},
+			"maxFaces": &graphql.Field{
+				Description: "Maximum number of faces.",
+				Type:        gqlserver.NonNullInt,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					l := p.Source.(*Listener)
+					return l.Config().MaxFaces, nil
+				},
+			},
+			"faces": &graphql.Field{
+				Description: "Faces created from accepted connections.",
+				Type:        gqlserver.NewListNonNullBoth(iface.GqlFaceType.Object),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					l := p.Source.(*Listener)
+					return l.Faces(), nil
+				},
+			},
+		},
+	}, gqlserver.NodeConfig[*Listener]{
+		RetrieveInt: GetListener,
+		Delete: func(l *Listener) error {
+			return l.Close()
+		},
+	})
+
+	gqlserver.AddQuery(&graphql.Field{
+		Name:        "socketListeners",
+		Description: "List of stream socket and WebSocket listeners.",
+		Type:        gqlserver.NewListNonNullBoth(GqlListenerType.Object),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return Listeners(), nil
+		},
+	})
+
+	gqlserver.AddMutation(&graphql.Field{
+		Name:        "createSocketListener",
+		Description: "Create a stream socket or WebSocket listener.",
+		Args: graphql.FieldConfigArgument{
+			"config": &graphql.ArgumentConfig{
+				Description: "JSON object that satisfies the schema of socketface.ListenerConfig.",
+				Type:        gqlserver.NonNullJSON,
+			},
+		},
+		Type: graphql.NewNonNull(GqlListenerType.Object),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			if !iface.GqlCreateFaceAllowed {
+				return nil, errGqlCreateListenerDisallowed
+			}
+
+			var cfg ListenerConfig
+			if e := jsonhelper.Roundtrip(p.Args["config"], &cfg, jsonhelper.DisallowUnknownFields); e != nil {
+				return nil, e
+			}
+			return Listen(cfg)
+		},
+	})
 }
@@ -0,0 +1,354 @@
+package socketface
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/sockettransport"
+	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// DefaultListenerMaxFaces is the default maximum number of faces per listener.
+const DefaultListenerMaxFaces = 256
+
+// ListenerConfig contains socket listener configuration.
+type ListenerConfig struct {
+	// Config is applied to each face created from an accepted connection.
+	// Redial settings are ignored, because an accepted connection cannot be redialed.
+	Config
+
+	// Network is "tcp", "unix", or "ws".
+	Network string `json:"scheme"`
+
+	// Local is the local address to listen on.
+	// For Unix sockets, an existing socket file at this path is removed.
+	// For WebSocket, this is a TCP address of a separate HTTP server; if empty, the WebSocket
+	// endpoint is served from the HTTP server of the GraphQL endpoint.
+	Local string `json:"local,omitempty"`
+
+	// Path is the HTTP path of the WebSocket endpoint.
+	// Default is DefaultWebSocketPath.
+	Path string `json:"path,omitempty"`
+
+	// MaxFaces is the maximum number of faces created from this listener.
+	// Further connections are closed immediately.
+	// Default is DefaultListenerMaxFaces.
+	MaxFaces int `json:"maxFaces,omitempty"`
+}
+
+func (cfg *ListenerConfig) applyDefaults() {
+	if cfg.MaxFaces <= 0 {
+		cfg.MaxFaces = DefaultListenerMaxFaces
+	}
+	if cfg.Network == schemeWebSocket && cfg.Path == "" {
+		cfg.Path = DefaultWebSocketPath
+	}
+}
+
+func (cfg ListenerConfig) validate() error {
+	switch cfg.Network {
+	case schemeTCP, "tcp4", "tcp6", schemeUnix:
+	case schemeWebSocket:
+		return cfg.validateWebSocket()
+	default:
+		return fmt.Errorf("listener scheme %s is not supported", cfg.Network)
+	}
+	if cfg.Local == "" {
+		return errors.New("listener local address is missing")
+	}
+	return nil
+}
+
+// Listener accepts stream socket or WebSocket connections and creates a socket face for each connection.
+// A face is closed when its peer disconnects.
+type Listener struct {
+	cfg      ListenerConfig
+	id       int
+	listener net.Listener
+	server   *http.Server
+	logger   *zap.Logger
+
+	mutex        sync.Mutex
+	faces        map[iface.ID]iface.Face
+	nPending     int // connections accepted but face not yet created, counted toward MaxFaces
+	closed       bool
+	cancelClosed func()
+}
+
+// acceptedConn tracks an accepted connection while its face is being created.
+// Its fields are protected by Listener.mutex.
+type acceptedConn struct {
+	id   iface.ID // face ID, zero until the face is inserted
+	down bool     // whether the transport went down before the face is inserted
+}
+
+// ID returns listener identifier.
+func (l *Listener) ID() int {
+	return l.id
+}
+
+// Config returns listener configuration.
+func (l *Listener) Config() ListenerConfig {
+	return l.cfg
+}
+
+// Addr returns the listening address.
+func (l *Listener) Addr() net.Addr {
+	if l.cfg.Network == schemeWebSocket {
+		return l.webSocketAddr()
+	}
+	return l.listener.Addr()
+}
+
+// Faces returns faces created from accepted connections that are still open.
+func (l *Listener) Faces() (list []iface.Face) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	list = maps.Values(l.faces)
+	slices.SortFunc(list, func(a, b iface.Face) bool { return a.ID() < b.ID() })
+	return list
+}
+
+// Close stops listening and closes all faces created from this listener.
+func (l *Listener) Close() error {
+	l.mutex.Lock()
+	if l.closed {
+		l.mutex.Unlock()
+		return nil
+	}
+	l.closed = true
+	faces := maps.Values(l.faces)
+	maps.Clear(l.faces)
+	l.mutex.Unlock()
+
+	listenersMutex.Lock()
+	delete(listeners, l.id)
+	listenersMutex.Unlock()
+
+	var errs []error
+	switch {
+	case l.server != nil:
+		errs = append(errs, l.server.Close())
+	case l.listener != nil:
+		errs = append(errs, l.listener.Close())
+	default:
+		l.unmountWebSocket()
+	}
+	for _, face := range faces {
+		errs = append(errs, face.Close())
+	}
+	l.cancelClosed()
+	l.logger.Info("listener closed")
+	return errors.Join(errs...)
+}
+
+func (l *Listener) acceptLoop() {
+	for {
+		conn, e := l.listener.Accept()
+		if e != nil {
+			if !errors.Is(e, net.ErrClosed) {
+				l.logger.Warn("accept error", zap.Error(e))
+			}
+			return
+		}
+		l.accept(conn)
+	}
+}
+
+func (l *Listener) accept(conn net.Conn) {
+	logEntry := l.logger.With(zap.Stringer("remote", conn.RemoteAddr()))
+
+	if !l.reserve() {
+		logEntry.Warn("connection rejected", zap.Int("max-faces", l.cfg.MaxFaces))
+		conn.Close()
+		return
+	}
+	face, e := l.createFace(conn)
+	if e != nil {
+		logEntry.Warn("face creation error", zap.Error(e))
+		return
+	}
+	logEntry.Info("face created from accepted connection", face.ID().ZapField("face"))
+}
+
+// reserve reserves a face slot for an accepted connection.
+// Returns false if the listener is closed or MaxFaces is reached.
+func (l *Listener) reserve() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.closed || len(l.faces)+l.nPending >= l.cfg.MaxFaces {
+		return false
+	}
+	l.nPending++
+	return true
+}
+
+// createFace creates a face from an accepted connection, consuming a slot reserved by reserve().
+func (l *Listener) createFace(conn net.Conn) (face iface.Face, e error) {
+	ac := &acceptedConn{}
+	defer func() {
+		l.mutex.Lock()
+		l.nPending--
+		switch {
+		case e != nil:
+		case l.closed:
+			e = errors.New("listener closed")
+		case ac.down:
+			e = errors.New("connection closed by peer")
+		default:
+			ac.id = face.ID()
+			l.faces[ac.id] = face
+		}
+		l.mutex.Unlock()
+
+		if e != nil && face != nil {
+			face.Close()
+		}
+	}()
+
+	tcfg := l.cfg.Config.transportConfig()
+	tcfg.DisableRedial = true
+	transport, e := sockettransport.New(conn, tcfg)
+	if e != nil {
+		conn.Close()
+		return nil, fmt.Errorf("sockettransport.New: %w", e)
+	}
+
+	// register before the face is exposed, so that an early disconnect is not missed
+	transport.OnStateChange(func(st l3.TransportState) {
+		if st != l3.TransportDown {
+			return
+		}
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		if ac.id == 0 {
+			ac.down = true
+		} else {
+			go l.closeFace(ac.id)
+		}
+	})
+	if transport.State() == l3.TransportDown {
+		l.mutex.Lock()
+		ac.down = true
+		l.mutex.Unlock()
+	}
+
+	if face, e = Wrap(transport, l.cfg.Config); e != nil {
+		transport.Close()
+		return nil, e
+	}
+	return face, nil
+}
+
+// isFull determines whether a new connection should be rejected.
+func (l *Listener) isFull() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.closed || len(l.faces)+l.nPending >= l.cfg.MaxFaces
+}
+
+// closeFace closes a face after its peer has disconnected.
+func (l *Listener) closeFace(id iface.ID) {
+	l.mutex.Lock()
+	face := l.faces[id]
+	delete(l.faces, id)
+	l.mutex.Unlock()
+
+	if face != nil {
+		l.logger.Info("closing face after peer disconnected", id.ZapField("face"))
+		face.Close()
+	}
+}
+
+var (
+	listenersMutex sync.Mutex
+	listeners      = map[int]*Listener{}
+	lastListenerID int
+)
+
+// Listen creates a listener.
+func Listen(cfg ListenerConfig) (l *Listener, e error) {
+	cfg.applyDefaults()
+	if e := cfg.validate(); e != nil {
+		return nil, e
+	}
+
+	if cfg.Network == schemeUnix {
+		if fi, e := os.Stat(cfg.Local); e == nil && fi.Mode().Type() == fs.ModeSocket {
+			os.Remove(cfg.Local)
+		}
+	}
+
+	l = &Listener{
+		cfg:   cfg,
+		faces: map[iface.ID]iface.Face{},
+	}
+	if cfg.Network == schemeWebSocket {
+		e = l.listenWebSocket()
+	} else {
+		l.listener, e = net.Listen(cfg.Network, cfg.Local)
+	}
+	if e != nil {
+		return nil, e
+	}
+
+	l.cancelClosed = iface.OnFaceClosed(func(id iface.ID) {
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		delete(l.faces, id)
+	})
+
+	listenersMutex.Lock()
+	lastListenerID++
+	l.id = lastListenerID
+	listeners[l.id] = l
+	listenersMutex.Unlock()
+
+	l.logger = logger.With(zap.Int("listener", l.id), zap.Stringer("local", l.Addr()))
+	switch {
+	case l.server != nil:
+		go l.server.Serve(l.listener)
+	case l.listener != nil:
+		go l.acceptLoop()
+	default:
+		if e := l.mountWebSocket(); e != nil {
+			l.Close()
+			return nil, e
+		}
+	}
+	l.logger.Info("listener started")
+	return l, nil
+}
+
+// Listeners returns a list of listeners.
+func Listeners() (list []*Listener) {
+	listenersMutex.Lock()
+	defer listenersMutex.Unlock()
+	list = maps.Values(listeners)
+	slices.SortFunc(list, func(a, b *Listener) bool { return a.id < b.id })
+	return list
+}
+
+// GetListener retrieves a listener by ID.
+func GetListener(id int) *Listener {
+	listenersMutex.Lock()
+	defer listenersMutex.Unlock()
+	return listeners[id]
+}
+
+func init() {
+	iface.OnCloseAll(func() {
+		for _, l := range Listeners() {
+			l.Close()
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package socketface_test
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/iface"
+	"github.com/usnistgov/ndn-dpdk/iface/ifacetestenv"
+	"github.com/usnistgov/ndn-dpdk/iface/socketface"
+	"github.com/usnistgov/ndn-dpdk/ndn/sockettransport"
+)
+
+func TestListener(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := ifacetestenv.NewFixture(t)
+	addr := filepath.Join(t.TempDir(), "listener.sock")
+
+	listener, e := socketface.Listen(socketface.ListenerConfig{
+		Network:  "unix",
+		Local:    addr,
+		MaxFaces: 1,
+	})
+	require.NoError(e)
+	defer listener.Close()
+	assert.Equal(listener, socketface.GetListener(listener.ID()))
+	assert.Contains(socketface.Listeners(), listener)
+
+	faceA, e := socketface.New(mustParseLocator(fmt.Sprintf(`{"scheme":"unix", "remote":"%s"}`, addr)))
+	require.NoError(e)
+	time.Sleep(100 * time.Millisecond)
+	faces := listener.Faces()
+	require.Len(faces, 1)
+	faceB := faces[0]
+	assert.True(faceB.IsLocal())
+
+	conn, e := net.Dial("unix", addr) // exceeds MaxFaces, closed by listener
+	require.NoError(e)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, e = conn.Read(make([]byte, 1))
+	assert.Error(e)
+	conn.Close()
+	assert.Len(listener.Faces(), 1)
+
+	fixture.RunTest(faceA, faceB)
+	fixture.CheckCounters()
+
+	idB := faceB.ID()
+	require.NoError(faceA.Close()) // peer disconnect closes faceB
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(listener.Faces(), 0)
+	assert.Nil(iface.Get(idB))
+
+	require.NoError(listener.Close())
+	assert.Nil(socketface.GetListener(listener.ID()))
+
+	_, e = socketface.Listen(socketface.ListenerConfig{Network: "udp", Local: "127.0.0.1:0"})
+	assert.Error(e)
+}
+
+func TestWebSocketListener(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := ifacetestenv.NewFixture(t)
+
+	listener, e := socketface.Listen(socketface.ListenerConfig{
+		Network: "ws",
+		Local:   "127.0.0.1:0",
+	})
+	require.NoError(e)
+	defer listener.Close()
+	assert.Equal("ws", listener.Addr().Network())
+	url := "ws://" + listener.Addr().String()
+	assert.Equal(socketface.DefaultWebSocketPath, url[len(url)-len(socketface.DefaultWebSocketPath):])
+
+	faceA, e := socketface.New(mustParseLocator(fmt.Sprintf(`{"scheme":"ws", "remote":"%s"}`, url)))
+	require.NoError(e)
+	time.Sleep(100 * time.Millisecond)
+	faces := listener.Faces()
+	require.Len(faces, 1)
+	faceB := faces[0]
+	assert.False(faceB.IsLocal())
+	assert.Equal("ws", faceB.Locator().Scheme())
+
+	fixture.RunTest(faceA, faceB)
+	fixture.CheckCounters()
+
+	idB := faceB.ID()
+	require.NoError(faceA.Close())
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(listener.Faces(), 0)
+	assert.Nil(iface.Get(idB))
+
+	_, e = sockettransport.Dial("ws", "", "ws://"+listener.Addr().String()+"x", sockettransport.Config{})
+	assert.Error(e)
+
+	_, e = socketface.Listen(socketface.ListenerConfig{Network: "ws", Path: "/"})
+	assert.Error(e)
+}
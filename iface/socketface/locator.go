@@ -2,6 +2,8 @@ package socketface
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 
 	"github.com/gogf/greuse"
 	"github.com/usnistgov/ndn-dpdk/iface"
@@ -11,6 +13,8 @@ const (
 	schemeUnix = "unix"
 	schemeUDP  = "udp"
 	schemeTCP  = "tcp"
+
+	schemeWebSocket = "ws"
 )
 
 // Locator describes network and addresses of a socket.
@@ -31,6 +35,13 @@ func (Locator) WithSchemeField() {}
 
 // Validate checks the addresses.
 func (loc Locator) Validate() error {
+	if loc.Network == schemeWebSocket {
+		if u, e := url.Parse(loc.Remote); e != nil || (u.Scheme != "ws" && u.Scheme != "wss") {
+			return fmt.Errorf("remote %s is not a WebSocket URL", loc.Remote)
+		}
+		return nil
+	}
+
 	_, eR := greuse.ResolveAddr(loc.Network, loc.Remote)
 	var eL error
 	if loc.Local != "" && !(loc.Network == schemeUnix && loc.Local == "@") {
@@ -45,5 +56,5 @@ func (loc Locator) CreateFace() (iface.Face, error) {
 }
 
 func init() {
-	iface.RegisterLocatorScheme[Locator](schemeUnix, schemeUDP, schemeTCP)
+	iface.RegisterLocatorScheme[Locator](schemeUnix, schemeUDP, schemeTCP, schemeWebSocket)
 }
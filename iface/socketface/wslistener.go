@@ -0,0 +1,119 @@
+package socketface
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/usnistgov/ndn-dpdk/ndn/sockettransport"
+	"go.uber.org/zap"
+)
+
+// DefaultWebSocketPath is the default HTTP path of a WebSocket listener.
+const DefaultWebSocketPath = "/ndn"
+
+var wsUpgrader = websocket.Upgrader{
+	// Browser applications may be loaded from any origin.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+var (
+	wsMountMutex sync.Mutex
+	wsMounts     = map[string]*Listener{} // path => listener; key exists if handler is registered
+)
+
+func (cfg ListenerConfig) validateWebSocket() error {
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return errors.New("WebSocket path must start with '/'")
+	}
+	if cfg.Local == "" && cfg.Path == "/" {
+		return errors.New("WebSocket path '/' is reserved for GraphQL endpoint")
+	}
+	return nil
+}
+
+func (l *Listener) webSocketAddr() net.Addr {
+	if l.listener == nil {
+		return sockettransport.WebSocketAddr(l.cfg.Path)
+	}
+	return sockettransport.WebSocketAddr(l.listener.Addr().String() + l.cfg.Path)
+}
+
+// listenWebSocket starts a separate HTTP server, if a local address is specified.
+// Otherwise, the listener is mounted on the GraphQL HTTP server later.
+func (l *Listener) listenWebSocket() (e error) {
+	if l.cfg.Local == "" {
+		return nil
+	}
+
+	if l.listener, e = net.Listen(schemeTCP, l.cfg.Local); e != nil {
+		return e
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(l.cfg.Path, l.serveWebSocket)
+	l.server = &http.Server{Handler: mux}
+	return nil
+}
+
+// mountWebSocket registers the listener on http.DefaultServeMux, which is used by GraphQL server.
+func (l *Listener) mountWebSocket() error {
+	path := l.cfg.Path
+	wsMountMutex.Lock()
+	defer wsMountMutex.Unlock()
+
+	if other, ok := wsMounts[path]; ok {
+		if other != nil {
+			return fmt.Errorf("WebSocket path %s is used by listener %d", path, other.id)
+		}
+	} else {
+		// http.ServeMux panics upon duplicate registration, and does not support unregistration.
+		// The handler is registered once and dispatches to the current listener on this path.
+		if _, pattern := http.DefaultServeMux.Handler(&http.Request{URL: &url.URL{Path: path}}); pattern == path {
+			return fmt.Errorf("HTTP path %s is in use", path)
+		}
+		http.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			wsMountMutex.Lock()
+			l := wsMounts[path]
+			wsMountMutex.Unlock()
+
+			if l == nil {
+				http.NotFound(w, r)
+				return
+			}
+			l.serveWebSocket(w, r)
+		})
+	}
+
+	wsMounts[path] = l
+	return nil
+}
+
+// unmountWebSocket unregisters the listener from http.DefaultServeMux.
+func (l *Listener) unmountWebSocket() {
+	wsMountMutex.Lock()
+	defer wsMountMutex.Unlock()
+	if wsMounts[l.cfg.Path] == l {
+		wsMounts[l.cfg.Path] = nil
+	}
+}
+
+func (l *Listener) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	if l.isFull() {
+		l.logger.Warn("WebSocket connection rejected", zap.String("remote", r.RemoteAddr), zap.Int("max-faces", l.cfg.MaxFaces))
+		http.Error(w, "too many faces", http.StatusServiceUnavailable)
+		return
+	}
+
+	conn, e := wsUpgrader.Upgrade(w, r, nil)
+	if e != nil {
+		l.logger.Warn("WebSocket upgrade error", zap.String("remote", r.RemoteAddr), zap.Error(e))
+		return
+	}
+	l.accept(sockettransport.NewWebSocketConn(conn, ""))
+}
@@ -0,0 +1,170 @@
+package iface
+
+/*
+#include "../csrc/iface/face.h"
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/usnistgov/ndn-dpdk/dpdk/eal"
+	"github.com/usnistgov/ndn-dpdk/dpdk/ringbuffer"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndni"
+	"github.com/zyedidia/generic"
+	"go4.org/must"
+	"golang.org/x/exp/slices"
+)
+
+// TxClassConfig contains TX priority class configuration.
+//
+// When configured, each TX class has its own packet queue before the output thread.
+// An outgoing packet is placed into a TX class chosen by the first of:
+// (1) TX class hint from the forwarding strategy;
+// (2) first matching name prefix rule, where longer prefixes are matched first;
+// (3) L3 packet type.
+type TxClassConfig struct {
+	// Weighted selects weighted round robin scheduling among TX classes.
+	// If false, TX classes are served in strict priority order, where class 0 has the highest priority.
+	Weighted bool `json:"weighted,omitempty"`
+
+	// Classes lists TX classes.
+	// Its length must be between 2 and MaxFaceTxClasses.
+	// To use a single queue, omit TxClassConfig and set Config.OutputQueueSize instead.
+	Classes []TxClass `json:"classes"`
+
+	// Interest is the TX class of Interest packets that do not match a rule.
+	Interest int `json:"interest,omitempty"`
+
+	// Data is the TX class of Data packets that do not match a rule.
+	Data int `json:"data,omitempty"`
+
+	// Nack is the TX class of Nack packets that do not match a rule.
+	Nack int `json:"nack,omitempty"`
+
+	// Rules lists name prefix rules.
+	// There can be up to MaxFaceTxClassRules rules.
+	Rules []TxClassRule `json:"rules,omitempty"`
+}
+
+// TxClass contains configuration of a TX class.
+type TxClass struct {
+	// QueueSize is the packet queue capacity of this TX class.
+	// It is adjusted in the same way as Config.OutputQueueSize.
+	QueueSize int `json:"queueSize,omitempty"`
+
+	// Weight is the maximum number of packets dequeued from this TX class in each burst,
+	// in weighted round robin scheduling.
+	// It is clamped between 1 and MaxBurstSize; default is 1.
+	Weight int `json:"weight,omitempty"`
+}
+
+// TxClassRule assigns packets under a name prefix to a TX class.
+type TxClassRule struct {
+	Prefix ndn.Name `json:"prefix"`
+	Class  int      `json:"class"`
+}
+
+func (c *TxClassConfig) applyDefaults() {
+	for i := range c.Classes {
+		cls := &c.Classes[i]
+		cls.QueueSize = ringbuffer.AlignCapacity(cls.QueueSize, MinOutputQueueSize, DefaultOutputQueueSize)
+		cls.Weight = generic.Clamp(cls.Weight, 1, MaxBurstSize)
+	}
+}
+
+func (c TxClassConfig) validate() error {
+	nClasses := len(c.Classes)
+	if nClasses < 2 || nClasses > MaxFaceTxClasses {
+		return fmt.Errorf("number of TX classes must be between 2 and %d", MaxFaceTxClasses)
+	}
+	if len(c.Rules) > MaxFaceTxClassRules {
+		return fmt.Errorf("number of TX class rules must not exceed %d", MaxFaceTxClassRules)
+	}
+
+	isValidClass := func(cls int) bool { return cls >= 0 && cls < nClasses }
+	if !isValidClass(c.Interest) || !isValidClass(c.Data) || !isValidClass(c.Nack) {
+		return errors.New("TX class of packet type out of range")
+	}
+	for _, rule := range c.Rules {
+		if !isValidClass(rule.Class) {
+			return fmt.Errorf("TX class of rule %s out of range", rule.Prefix)
+		}
+	}
+	return nil
+}
+
+// setupTxClasses creates TX class queues and classifier.
+// c.outputQueue is set to the queue of class 0.
+func setupTxClasses(c *C.Face, cfg TxClassConfig, socket eal.NumaSocket) error {
+	txc := &c.impl.txc
+	for i, cls := range cfg.Classes {
+		q, e := ringbuffer.New(cls.QueueSize, socket, ringbuffer.ProducerMulti, ringbuffer.ConsumerSingle)
+		if e != nil {
+			return e
+		}
+		txc.cls[i].queue = (*C.struct_rte_ring)(q.Ptr())
+		if cfg.Weighted {
+			txc.cls[i].weight = C.uint32_t(cls.Weight)
+		}
+	}
+	c.nTxClasses = C.uint8_t(len(cfg.Classes))
+	c.outputQueue = txc.cls[0].queue
+	txc.weighted = C.bool(cfg.Weighted)
+
+	txc.byType[ndni.PktFragment] = C.uint8_t(cfg.Interest)
+	txc.byType[ndni.PktInterest] = C.uint8_t(cfg.Interest)
+	txc.byType[ndni.PktData] = C.uint8_t(cfg.Data)
+	txc.byType[ndni.PktNack] = C.uint8_t(cfg.Nack)
+
+	// sort by descending name length for longest prefix match
+	rules := slices.Clone(cfg.Rules)
+	slices.SortStableFunc(rules, func(a, b TxClassRule) bool { return len(a.Prefix) > len(b.Prefix) })
+	prefixes := ndni.NewLNamePrefixFilterBuilder(unsafe.Pointer(&txc.ruleL), unsafe.Sizeof(txc.ruleL),
+		unsafe.Pointer(&txc.ruleV), unsafe.Sizeof(txc.ruleV))
+	for i, rule := range rules {
+		if e := prefixes.Append(rule.Prefix); e != nil {
+			return errors.New("TX class rule names too long")
+		}
+		txc.ruleClass[i] = C.uint8_t(rule.Class)
+	}
+	return nil
+}
+
+// clearTxClasses releases TX class queues.
+// c.outputQueue is cleared if it refers to a TX class queue.
+func clearTxClasses(c *C.Face) {
+	txc := &c.impl.txc
+	for i := range txc.cls {
+		if q := txc.cls[i].queue; q != nil {
+			if q == c.outputQueue {
+				c.outputQueue = nil
+			}
+			must.Close(ringbuffer.FromPtr(unsafe.Pointer(q)))
+			txc.cls[i].queue = nil
+		}
+	}
+	c.nTxClasses = 0
+}
+
+// TxClassCounters contains counters of a TX class.
+type TxClassCounters struct {
+	TxPackets uint64 `json:"txPackets" gqldesc:"L3 packets dequeued for transmission."`
+	TxDropped uint64 `json:"txDropped" gqldesc:"L3 packets dropped due to full queue."`
+}
+
+func (cnt TxClassCounters) String() string {
+	return fmt.Sprintf("%dpkt %ddropped", cnt.TxPackets, cnt.TxDropped)
+}
+
+func readTxClassCounters(c *C.Face) (list []TxClassCounters) {
+	for i := 0; i < int(c.nTxClasses); i++ {
+		list = append(list, TxClassCounters{
+			TxPackets: uint64(c.impl.tx[0].nClassPkts[i]),
+			TxDropped: uint64(c.impl.txc.cls[i].nDrops),
+		})
+	}
+	return list
+}
@@ -145,6 +145,18 @@ func ListTxLoops() (list []TxLoop) {
 	return txLoopThreads.Keys()
 }
 
+// TxLoopByLCore returns the TxLoop running on the specified lcore, or nil if it does not exist.
+func TxLoopByLCore(lc eal.LCore) (txl TxLoop) {
+	txLoopLock.Lock()
+	defer txLoopLock.Unlock()
+	txLoopThreads.Each(func(t TxLoop) {
+		if t.LCore() == lc {
+			txl = t
+		}
+	})
+	return txl
+}
+
 // ActivateTxFace selects an TxLoop and adds the face to it.
 // Returns chosen TxLoop.
 //
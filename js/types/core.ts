@@ -63,3 +63,18 @@ export interface RunningStatSnapshot {
   /** Internal variable M2. */
   m2: number;
 }
+
+/** Snapshot from runningstat with estimated percentiles. */
+export interface RunningStatHistSnapshot extends RunningStatSnapshot {
+  /** Estimated 50th percentile. */
+  p50: number;
+
+  /** Estimated 90th percentile. */
+  p90: number;
+
+  /** Estimated 99th percentile. */
+  p99: number;
+
+  /** Estimated 99.9th percentile. */
+  p999: number;
+}
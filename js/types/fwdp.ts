@@ -1,4 +1,4 @@
-import type { Uint } from "./core.js";
+import type { NNMilliseconds, Uint } from "./core.js";
 import type { BdevLocator } from "./dpdk.js";
 import type { FibConfig } from "./fib.js";
 import type { NdtConfig } from "./ndt.js";
@@ -15,6 +15,14 @@ export interface FwdpConfig {
   fib?: FibConfig;
   pcct?: PcctConfig;
   suppress?: SuppressConfig;
+
+  /**
+   * Per-prefix forwarding policies.
+   * A FIB entry selects a policy by its index in this list.
+   * @maxItems 8
+   */
+  policies?: FwdpPolicyConfig[];
+
   crypto?: FwdpCryptoConfig;
   disk?: FwdpDiskConfig;
   fwdInterestQueue?: PktQueueConfig;
@@ -23,6 +31,16 @@ export interface FwdpConfig {
   latencySampleInterval?: Uint;
 }
 
+export interface FwdpPolicyConfig {
+  suppress?: SuppressConfig;
+
+  /**
+   * @minimum 1
+   * @default 120000
+   */
+  maxLifetime?: NNMilliseconds;
+}
+
 export interface FwdpCryptoConfig {
   inputCapacity?: Uint;
   opPoolCapacity?: Uint;
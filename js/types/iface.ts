@@ -1,5 +1,6 @@
-import type { Counter, NNMilliseconds, Uint } from "./core.js";
+import type { Counter, NNMilliseconds, RunningStatHistSnapshot, Uint } from "./core.js";
 import type { EthNetifConfig } from "./dpdk.js";
+import type { Name } from "./ndni.js";
 
 /**
  * Numeric face identifier.
@@ -12,7 +13,7 @@ export type FaceID = Uint;
  * Face locator.
  * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface#Locator>
  */
-export type FaceLocator = EtherLocator | UdpLocator | VxlanLocator | MemifLocator | SocketFaceLocator;
+export type FaceLocator = EtherLocator | UdpLocator | VxlanLocator | GtpLocator | MemifLocator | SocketFaceLocator;
 
 /**
  * Face configuration.
@@ -37,6 +38,108 @@ export interface FaceConfig {
    * @maximum 65000
    */
   mtu?: Uint;
+
+  /**
+   * Whether the face is local, for /localhost and /localhop scope control.
+   * Default is true for memif and Unix socket faces, false for other faces.
+   */
+  isLocal?: boolean;
+
+  /**
+   * TX priority classes.
+   * If specified, outputQueueSize is ignored.
+   */
+  txClasses?: FaceTxClassConfig;
+
+  /**
+   * Sample interval of latency statistics.
+   * @default 16
+   */
+  latencySampleInterval?: Uint;
+
+  /**
+   * Liveness probing.
+   * If specified, the face transmits NDNLPv2 IDLE packets and is set DOWN when the peer is silent.
+   */
+  liveness?: FaceLivenessConfig;
+}
+
+/**
+ * Face liveness probing configuration.
+ * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface#LivenessConfig>
+ */
+export interface FaceLivenessConfig {
+  /**
+   * Probing interval.
+   * @default 1000
+   */
+  interval?: NNMilliseconds;
+
+  /**
+   * Number of consecutive intervals without RX frames before the face is set DOWN.
+   * @minimum 1
+   * @default 3
+   */
+  missCount?: Uint;
+}
+
+/**
+ * Face TX priority class configuration.
+ * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface#TxClassConfig>
+ */
+export interface FaceTxClassConfig {
+  /**
+   * Weighted round robin scheduling if true, strict priority scheduling if false.
+   * @default false
+   */
+  weighted?: boolean;
+
+  /**
+   * @minItems 2
+   * @maxItems 4
+   */
+  classes: FaceTxClass[];
+
+  /**
+   * TX class of Interests.
+   * @default 0
+   */
+  interest?: Uint;
+
+  /**
+   * TX class of Data.
+   * @default 0
+   */
+  data?: Uint;
+
+  /**
+   * TX class of Nacks.
+   * @default 0
+   */
+  nack?: Uint;
+
+  /** @maxItems 8 */
+  rules?: FaceTxClassRule[];
+}
+
+export interface FaceTxClass {
+  /**
+   * @minimum 256
+   * @default 1024
+   */
+  queueSize?: Uint;
+
+  /**
+   * @minimum 1
+   * @maximum 64
+   * @default 1
+   */
+  weight?: Uint;
+}
+
+export interface FaceTxClassRule {
+  prefix: Name;
+  class: Uint;
 }
 
 /**
@@ -63,6 +166,12 @@ export type EthPortConfig = EthNetifConfig & {
   mtu?: Uint;
 
   rxFlowQueues?: number;
+
+  /**
+   * @minimum 1
+   * @default 1
+   */
+  txQueues?: Uint;
 };
 
 interface EtherLocatorBase extends FaceConfig {
@@ -78,6 +187,18 @@ interface EtherLocatorBase extends FaceConfig {
   disableTxMultiSegOffload?: boolean;
   disableTxChecksumOffload?: boolean;
 
+  /**
+   * TX queue index within Ethernet port txQueues.
+   * @minimum 0
+   */
+  txQueue?: Uint;
+
+  /**
+   * lcore ID of TxLoop serving the TX queue.
+   * @minimum 0
+   */
+  txLCore?: Uint;
+
   local: string;
   remote: string;
 
@@ -134,6 +255,41 @@ export interface VxlanLocator extends IpLocatorBase {
   innerRemote: string;
 }
 
+/**
+ * GTP-U face locator.
+ * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface/ethface#GtpLocator>
+ */
+export interface GtpLocator extends IpLocatorBase {
+  scheme: "gtp";
+
+  /**
+   * @minimum 1
+   * @maximum 4294967295
+   */
+  ulTEID: Uint;
+
+  /**
+   * @minimum 0
+   * @maximum 63
+   */
+  ulQFI: Uint;
+
+  /**
+   * @minimum 1
+   * @maximum 4294967295
+   */
+  dlTEID: Uint;
+
+  /**
+   * @minimum 0
+   * @maximum 63
+   */
+  dlQFI: Uint;
+
+  innerLocalIP?: string;
+  innerRemoteIP?: string;
+}
+
 export type MemifRole = "server" | "client";
 
 /**
@@ -168,6 +324,25 @@ export interface MemifLocator {
    * @default 1024
    */
   ringCapacity?: Uint;
+
+  /**
+   * Number of queue pairs.
+   * @minimum 1
+   * @maximum 8
+   * @default 1
+   */
+  nQueues?: Uint;
+
+  /**
+   * Enable zero-copy mode, requires "client" role.
+   * @default false
+   */
+  zeroCopy?: boolean;
+
+  /**
+   * @default true
+   */
+  isLocal?: boolean;
 }
 
 /**
@@ -219,17 +394,50 @@ export interface SocketFaceConfig extends FaceConfig {
  * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface/socketface#Locator>
  */
 export interface SocketFaceLocator extends SocketFaceConfig {
-  scheme: "udp" | "tcp" | "unix";
+  scheme: "udp" | "tcp" | "unix" | "ws";
   local?: string;
   remote: string;
 }
 
+/**
+ * Socket listener configuration.
+ * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface/socketface#ListenerConfig>
+ */
+export interface SocketListenerConfig extends FaceConfig {
+  scheme: "tcp" | "unix" | "ws";
+
+  /**
+   * Listening address.
+   * With "ws" scheme, omit to serve from the GraphQL HTTP server.
+   */
+  local?: string;
+
+  /**
+   * WebSocket HTTP path.
+   * @default "/ndn"
+   */
+  path?: string;
+
+  /**
+   * @minimum 960
+   * @maximum 65000
+   */
+  mtu?: Uint;
+
+  /**
+   * @default 256
+   */
+  maxFaces?: Uint;
+}
+
 /**
  * Face counters.
  * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface#Counters>
  */
 export interface FaceCounters extends FaceRxCounters, FaceTxCounters {
   rxThreads: FaceRxCounters[];
+  txQueueDropped: Counter;
+  txClasses?: FaceTxClassCounters[];
 }
 
 export interface FaceRxCounters {
@@ -242,6 +450,7 @@ export interface FaceRxCounters {
   rxDecodeErrs: Counter;
   rxReassPackets: Counter;
   rxReassDrops: Counter;
+  rxIdle: Counter;
 }
 
 export interface FaceTxCounters {
@@ -255,4 +464,30 @@ export interface FaceTxCounters {
   txFragBad: Counter;
   txAllocErrs: Counter;
   txDropped: Counter;
+  txIdle: Counter;
+}
+
+/**
+ * Face TX latency statistics, in nanoseconds.
+ * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface#TxLatency>
+ */
+export interface FaceTxLatency {
+  sojourn: RunningStatHistSnapshot;
+  residence?: RunningStatHistSnapshot;
+}
+
+/**
+ * Face event, as delivered by faceEvents subscription.
+ * @see <https://pkg.go.dev/github.com/usnistgov/ndn-dpdk/iface#FaceEvent>
+ */
+export interface FaceEvent {
+  kind: "CREATED" | "UP" | "DOWN" | "CLOSING";
+  nid: Uint;
+  locator?: FaceLocator;
+  timestamp: string;
+}
+
+export interface FaceTxClassCounters {
+  txPackets: Counter;
+  txDropped: Counter;
 }
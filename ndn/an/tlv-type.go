@@ -12,6 +12,8 @@ const (
 	TtPitToken       = 0x62
 	TtNack           = 0x0320
 	TtNackReason     = 0x0321
+	TtIncomingFaceID = 0x032C
+	TtNextHopFaceID  = 0x0330
 	TtCongestionMark = 0x0340
 
 	TtName                            = 0x07
@@ -35,6 +35,10 @@ func (face lFaceL3) OnStateChange(cb func(st l3.TransportState)) (cancel func())
 	panic("not supported")
 }
 
+func (face lFaceL3) ReassemblerCounters() (cnt ndn.LpReassemblerCounters) {
+	return
+}
+
 // LFace is a logical face between endpoint (consumer or producer) and internal forwarder.
 type LFace struct {
 	ep2fw  chan *ndn.Packet
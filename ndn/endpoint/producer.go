@@ -55,6 +55,12 @@ type ProducerOptions struct {
 	// DataSigner automatically signs Data packets unless already signed.
 	// Default is keeping the Null signature.
 	DataSigner ndn.Signer
+
+	// InterestVerifier verifies Interests before they are passed to Handler.
+	// Interests that fail verification are dropped.
+	// This may be combined with ndn.ReplayChecker to reject replayed Signed Interests.
+	// Default is no verification.
+	InterestVerifier ndn.Verifier
 }
 
 // Produce starts a producer.
@@ -131,6 +137,9 @@ func (p *producer) handleInterest(ctx context.Context, wg *sync.WaitGroup, pkt *
 	if !p.Prefix.IsPrefixOf(interest.Name) {
 		return
 	}
+	if p.InterestVerifier != nil && p.InterestVerifier.Verify(*interest) != nil {
+		return
+	}
 
 	ctx1, cancel1 := context.WithTimeout(ctx, interest.ApplyDefaultLifetime())
 	defer cancel1()
@@ -16,6 +16,7 @@ import (
 	"github.com/usnistgov/ndn-dpdk/ndn/ndntestenv"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
 	"go4.org/must"
+	"golang.org/x/exp/slices"
 )
 
 func TestSignVerify(t *testing.T) {
@@ -62,6 +63,36 @@ func TestSignVerify(t *testing.T) {
 	}
 }
 
+func TestProducerInterestVerifier(t *testing.T) {
+	fw := l3.NewForwarder()
+	assert, require := makeAR(t)
+
+	rc := ndn.NewReplayChecker(ndn.ReplayCheckerOptions{Nonce: true, Time: true})
+	p, e := endpoint.Produce(context.Background(), endpoint.ProducerOptions{
+		Prefix: ndn.ParseName("/A"),
+		Handler: func(ctx context.Context, interest ndn.Interest) (ndn.Data, error) {
+			return ndn.MakeData(interest), nil
+		},
+		Fw:               fw,
+		InterestVerifier: rc.Wrap(ndn.DigestSigning),
+	})
+	require.NoError(e)
+	defer p.Close()
+
+	signer := ndn.NewInterestSigner(ndn.DigestSigning, ndn.InterestSignerOptions{Nonce: true, Time: true})
+	consume := func(interest ndn.Interest) error {
+		interest.Lifetime = 100 * time.Millisecond
+		_, e := endpoint.Consume(context.Background(), interest, endpoint.ConsumerOptions{Fw: fw})
+		return e
+	}
+
+	signed := ndn.MakeInterest("/A/1", []byte{0xC0})
+	require.NoError(signer.Sign(&signed))
+	assert.NoError(consume(signed))
+	assert.ErrorIs(consume(signed), endpoint.ErrExpire)
+	assert.ErrorIs(consume(ndn.MakeInterest("/A/2")), endpoint.ErrExpire)
+}
+
 func TestProducerNonMatch(t *testing.T) {
 	t.Cleanup(l3.DeleteDefaultForwarder)
 	assert, require := makeAR(t)
@@ -172,20 +203,31 @@ var producerHandlerNever endpoint.ProducerHandler = func(ctx context.Context, in
 }
 
 type readvertiseDestinationMock struct {
+	mutex      sync.Mutex
 	advertised []ndn.Name
 	withdrawn  []ndn.Name
 }
 
 func (dest *readvertiseDestinationMock) Advertise(prefix ndn.Name) error {
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
 	dest.advertised = append(dest.advertised, prefix)
 	return nil
 }
 
 func (dest *readvertiseDestinationMock) Withdraw(prefix ndn.Name) error {
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
 	dest.withdrawn = append(dest.withdrawn, prefix)
 	return nil
 }
 
+func (dest *readvertiseDestinationMock) Lists() (advertised, withdrawn []ndn.Name) {
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
+	return slices.Clone(dest.advertised), slices.Clone(dest.withdrawn)
+}
+
 func TestProducerAdvertise(t *testing.T) {
 	t.Cleanup(l3.DeleteDefaultForwarder)
 	assert, require := makeAR(t)
@@ -199,8 +241,9 @@ func TestProducerAdvertise(t *testing.T) {
 	})
 	require.NoError(e)
 	time.Sleep(50 * time.Millisecond)
-	if assert.Len(dest.advertised, 1) {
-		nameEqual(assert, dest.advertised[0], "/A")
+	advertised, _ := dest.Lists()
+	if assert.Len(advertised, 1) {
+		nameEqual(assert, advertised[0], "/A")
 	}
 
 	p2, e := endpoint.Produce(context.Background(), endpoint.ProducerOptions{
@@ -209,16 +252,19 @@ func TestProducerAdvertise(t *testing.T) {
 	})
 	require.NoError(e)
 	time.Sleep(50 * time.Millisecond)
-	assert.Len(dest.advertised, 1)
+	advertised, _ = dest.Lists()
+	assert.Len(advertised, 1)
 
 	must.Close(p1)
 	time.Sleep(50 * time.Millisecond)
-	assert.Len(dest.withdrawn, 0)
+	_, withdrawn := dest.Lists()
+	assert.Len(withdrawn, 0)
 
 	must.Close(p2)
 	time.Sleep(50 * time.Millisecond)
-	if assert.Len(dest.withdrawn, 1) {
-		nameEqual(assert, dest.withdrawn[0], "/A")
+	_, withdrawn = dest.Lists()
+	if assert.Len(withdrawn, 1) {
+		nameEqual(assert, withdrawn[0], "/A")
 	}
 }
 
@@ -236,9 +282,11 @@ func TestProducerNoAdvertise(t *testing.T) {
 	})
 	require.NoError(e)
 	time.Sleep(50 * time.Millisecond)
-	assert.Len(dest.advertised, 0)
+	advertised, _ := dest.Lists()
+	assert.Len(advertised, 0)
 
 	must.Close(p)
 	time.Sleep(50 * time.Millisecond)
-	assert.Len(dest.withdrawn, 0)
+	_, withdrawn := dest.Lists()
+	assert.Len(withdrawn, 0)
 }
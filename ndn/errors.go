@@ -17,5 +17,7 @@ var (
 	ErrSigType       = errors.New("bad SigType")
 	ErrKeyLocator    = errors.New("bad KeyLocator")
 	ErrSigNonce      = errors.New("bad SigNonce")
+	ErrSigTime       = errors.New("bad SigTime")
+	ErrSigSeqNum     = errors.New("bad SigSeqNum")
 	ErrSigValue      = errors.New("bad SigValue")
 )
@@ -0,0 +1,110 @@
+package keychain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+)
+
+// HMACKey is a shared secret key for SigHmacWithSha256 signature type.
+// It can both sign and verify packets.
+type HMACKey interface {
+	PrivateKey
+	ndn.Verifier
+
+	// KeyDigest returns SHA-256 digest of the secret, as used in KeyLocator KeyDigest field.
+	KeyDigest() []byte
+}
+
+type hmacSigner struct {
+	key *hmacKey
+	kl  ndn.KeyLocator
+}
+
+func (signer hmacSigner) Sign(packet ndn.Signable) error {
+	return packet.SignWith(func(_ ndn.Name, si *ndn.SigInfo) (ndn.LLSign, error) {
+		si.Type = an.SigHmacWithSha256
+		si.KeyLocator = signer.kl
+		return signer.key.llSign, nil
+	})
+}
+
+type hmacKey struct {
+	hmacSigner
+	name   ndn.Name
+	secret []byte
+	digest []byte
+}
+
+func (key *hmacKey) Name() ndn.Name {
+	return key.name
+}
+
+func (key *hmacKey) KeyDigest() []byte {
+	return key.digest
+}
+
+func (key *hmacKey) WithKeyLocator(klName ndn.Name) ndn.Signer {
+	return &hmacSigner{key: key, kl: ndn.KeyLocator{Name: klName}}
+}
+
+func (key *hmacKey) Verify(packet ndn.Verifiable) error {
+	return packet.VerifyWith(func(_ ndn.Name, si ndn.SigInfo) (ndn.LLVerify, error) {
+		if si.Type != an.SigHmacWithSha256 {
+			return nil, ndn.ErrSigType
+		}
+		switch {
+		case len(si.KeyLocator.Digest) > 0:
+			if !hmac.Equal(si.KeyLocator.Digest, key.digest) {
+				return nil, ndn.ErrKeyLocator
+			}
+		case len(si.KeyLocator.Name) > 0:
+			if !si.KeyLocator.Name.Equal(key.name) {
+				return nil, ndn.ErrKeyLocator
+			}
+		}
+		return key.llVerify, nil
+	})
+}
+
+func (key *hmacKey) llSign(input []byte) (sig []byte, e error) {
+	h := hmac.New(sha256.New, key.secret)
+	h.Write(input)
+	return h.Sum(nil), nil
+}
+
+func (key *hmacKey) llVerify(input, sig []byte) error {
+	expected, _ := key.llSign(input)
+	if !hmac.Equal(sig, expected) {
+		return ndn.ErrSigValue
+	}
+	return nil
+}
+
+// NewHMACKey creates a shared secret key for SigHmacWithSha256 signature type.
+//
+// If keyName is non-empty, signed packets carry keyName in KeyLocator Name field.
+// Otherwise, signed packets carry SHA-256 digest of the secret in KeyLocator KeyDigest field.
+// Verification accepts either form of KeyLocator that refers to this key.
+func NewHMACKey(keyName ndn.Name, secret []byte) (HMACKey, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("empty HMAC secret")
+	}
+
+	digest := sha256.Sum256(secret)
+	key := &hmacKey{
+		name:   keyName,
+		secret: append([]byte{}, secret...),
+		digest: digest[:],
+	}
+	key.hmacSigner.key = key
+	if len(keyName) > 0 {
+		key.kl.Name = keyName
+	} else {
+		key.kl.Digest = key.digest
+	}
+	return key, nil
+}
@@ -0,0 +1,67 @@
+package keychain_test
+
+import (
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/ndntestenv"
+	"github.com/usnistgov/ndn-dpdk/ndn/ndntestvector"
+)
+
+func TestHMACSigning(t *testing.T) {
+	assert, require := makeAR(t)
+
+	_, e := keychain.NewHMACKey(nil, nil)
+	assert.Error(e)
+
+	keyA, e := keychain.NewHMACKey(nil, []byte("secret-A"))
+	require.NoError(e)
+	keyB, e := keychain.NewHMACKey(ndn.ParseName("/K/B"), []byte("secret-B"))
+	require.NoError(e)
+	nameEqual(assert, "/K/B", keyB)
+
+	var c ndntestenv.SignVerifyTester
+	c.PvtA, c.PvtB, c.PubA, c.PubB = keyA, keyB, keyA, keyB
+	c.CheckInterest(t)
+	c.CheckInterestParameterized(t)
+	rec := c.CheckData(t)
+
+	dataA := rec.PktA.(*ndn.Data)
+	assert.EqualValues(an.SigHmacWithSha256, dataA.SigInfo.Type)
+	assert.Equal(keyA.KeyDigest(), dataA.SigInfo.KeyLocator.Digest)
+	dataB := rec.PktB.(*ndn.Data)
+	assert.EqualValues(an.SigHmacWithSha256, dataB.SigInfo.Type)
+	nameEqual(assert, keyB, dataB.SigInfo.KeyLocator)
+
+	data := ndn.MakeData("/D")
+	require.NoError(keyB.WithKeyLocator(ndn.ParseName("/K/C")).Sign(&data))
+	assert.ErrorIs(keyB.Verify(data), ndn.ErrKeyLocator)
+}
+
+func TestHMACVerify(t *testing.T) {
+	assert, require := makeAR(t)
+
+	keyDigest, e := keychain.NewHMACKey(nil, ndntestvector.HMACSecret)
+	require.NoError(e)
+	keyName, e := keychain.NewHMACKey(ndn.ParseName("/hmac/KEY/Jefe"), ndntestvector.HMACSecret)
+	require.NoError(e)
+	keyOther, e := keychain.NewHMACKey(nil, []byte("other"))
+	require.NoError(e)
+
+	dataDigest := ndntestvector.HMACKeyDigestDemo()
+	assert.Equal(keyDigest.KeyDigest(), dataDigest.SigInfo.KeyLocator.Digest)
+	assert.NoError(keyDigest.Verify(dataDigest))
+	assert.NoError(keyName.Verify(dataDigest))
+	assert.Error(keyOther.Verify(dataDigest))
+
+	dataName := ndntestvector.HMACKeyNameDemo()
+	nameEqual(assert, "/hmac/KEY/Jefe", dataName.SigInfo.KeyLocator)
+	assert.NoError(keyName.Verify(dataName))
+	assert.ErrorIs(keyDigest.Verify(dataName), ndn.ErrKeyLocator)
+
+	signed := ndn.MakeData(dataDigest.Name, dataDigest.Content)
+	require.NoError(keyDigest.Sign(&signed))
+	assert.Equal(dataDigest.SigValue, signed.SigValue)
+}
@@ -0,0 +1,422 @@
+package keychain
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"golang.org/x/exp/slices"
+)
+
+// ErrNoKey indicates a certificate is being inserted without its private key.
+var ErrNoKey = errors.New("private key of certificate is not in keychain")
+
+// KeyChain stores identities, keys, and certificates in a Store.
+//
+// An identity is identified by its subject name, and has zero or more keys.
+// A key is identified by its key name, and has zero or more certificates.
+// There is one default identity, one default key in each identity, and one default certificate of each key.
+// When the first key of an identity or the first certificate of a key is inserted, it automatically
+// becomes the default; when a default is deleted, another remaining object becomes the default.
+type KeyChain struct {
+	mutex sync.Mutex
+	store Store
+}
+
+// NewKeyChain creates a KeyChain on a Store.
+func NewKeyChain(store Store) *KeyChain {
+	return &KeyChain{store: store}
+}
+
+// OpenKeyChain opens or creates a KeyChain in a filesystem directory.
+// It is equivalent to NewKeyChain on NewDirStore.
+func OpenKeyChain(dir string) (*KeyChain, error) {
+	store, e := NewDirStore(dir)
+	if e != nil {
+		return nil, e
+	}
+	return NewKeyChain(store), nil
+}
+
+// Store returns the underlying Store.
+func (kc *KeyChain) Store() Store {
+	return kc.store
+}
+
+// Identities returns subject names of all identities, in canonical order.
+func (kc *KeyChain) Identities() (names []ndn.Name, e error) {
+	keys, e := kc.listSorted(StoreKey)
+	if e != nil {
+		return nil, e
+	}
+	for _, keyName := range keys {
+		names = append(names, ToSubjectName(keyName))
+	}
+	slices.SortFunc(names, func(a, b ndn.Name) bool { return a.Compare(b) < 0 })
+	return slices.CompactFunc(names, ndn.Name.Equal), nil
+}
+
+// Keys returns key names of an identity, in canonical order.
+func (kc *KeyChain) Keys(identity ndn.Name) (names []ndn.Name, e error) {
+	return kc.listUnder(StoreKey, identity, ToSubjectName)
+}
+
+// Certs returns certificate names of a key, in canonical order.
+func (kc *KeyChain) Certs(keyName ndn.Name) (names []ndn.Name, e error) {
+	return kc.listUnder(StoreCert, keyName, ToKeyName)
+}
+
+func (kc *KeyChain) listSorted(kind StoreKind) (names []ndn.Name, e error) {
+	if names, e = kc.store.List(kind); e != nil {
+		return nil, e
+	}
+	slices.SortFunc(names, func(a, b ndn.Name) bool { return a.Compare(b) < 0 })
+	return names, nil
+}
+
+func (kc *KeyChain) listUnder(kind StoreKind, parent ndn.Name, toParent func(ndn.Name) ndn.Name) (names []ndn.Name, e error) {
+	all, e := kc.listSorted(kind)
+	if e != nil {
+		return nil, e
+	}
+	for _, name := range all {
+		if toParent(name).Equal(parent) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// PrivateKey retrieves a private key by key name.
+func (kc *KeyChain) PrivateKey(keyName ndn.Name) (PrivateKey, error) {
+	wire, e := kc.store.Get(StoreKey, keyName)
+	if e != nil {
+		return nil, e
+	}
+	return UnmarshalKey(wire)
+}
+
+// Cert retrieves a certificate by certificate name.
+func (kc *KeyChain) Cert(certName ndn.Name) (*Certificate, error) {
+	wire, e := kc.store.Get(StoreCert, certName)
+	if e != nil {
+		return nil, e
+	}
+	return UnmarshalCert(wire)
+}
+
+// AddKey inserts a private key.
+func (kc *KeyChain) AddKey(pvt PrivateKey) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	return kc.addKey(pvt)
+}
+
+func (kc *KeyChain) addKey(pvt PrivateKey) error {
+	wire, e := MarshalKey(pvt)
+	if e != nil {
+		return e
+	}
+	keyName := pvt.Name()
+	if e := kc.store.Put(StoreKey, keyName, wire); e != nil {
+		return e
+	}
+	return kc.fillDefault(ToSubjectName(keyName), keyName)
+}
+
+// AddCert inserts a certificate.
+// The private key of the certificate must exist in the KeyChain.
+func (kc *KeyChain) AddCert(cert *Certificate) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	return kc.addCert(cert)
+}
+
+func (kc *KeyChain) addCert(cert *Certificate) error {
+	certName := cert.Name()
+	keyName := ToKeyName(certName)
+	if _, e := kc.store.Get(StoreKey, keyName); errors.Is(e, ErrNotFound) {
+		return ErrNoKey
+	} else if e != nil {
+		return e
+	}
+
+	wire, e := MarshalCert(cert)
+	if e != nil {
+		return e
+	}
+	if e := kc.store.Put(StoreCert, certName, wire); e != nil {
+		return e
+	}
+	return kc.fillDefault(keyName, certName)
+}
+
+// CreateKey generates a key pair in an identity, and inserts the private key along with a self-signed certificate.
+// newKeyPair is a key pair generator such as NewECDSAKeyPair.
+func (kc *KeyChain) CreateKey(identity ndn.Name,
+	newKeyPair func(name ndn.Name) (PrivateKey, PublicKey, error)) (pvt PrivateKey, cert *Certificate, e error) {
+	pvt, pub, e := newKeyPair(ToSubjectName(identity))
+	if e != nil {
+		return nil, nil, e
+	}
+	if cert, e = MakeCert(pub, pvt, MakeCertOptions{IssuerID: ComponentSelfIssuer}); e != nil {
+		return nil, nil, e
+	}
+
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	if e := kc.addKey(pvt); e != nil {
+		return nil, nil, e
+	}
+	if e := kc.addCert(cert); e != nil {
+		return nil, nil, e
+	}
+	return pvt, cert, nil
+}
+
+// DeleteIdentity deletes an identity with all its keys and certificates.
+func (kc *KeyChain) DeleteIdentity(identity ndn.Name) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+
+	keys, e := kc.Keys(identity)
+	if e != nil {
+		return e
+	}
+	for _, keyName := range keys {
+		if e := kc.deleteKey(keyName); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// DeleteKey deletes a key with all its certificates.
+func (kc *KeyChain) DeleteKey(keyName ndn.Name) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	return kc.deleteKey(keyName)
+}
+
+func (kc *KeyChain) deleteKey(keyName ndn.Name) error {
+	certs, e := kc.Certs(keyName)
+	if e != nil {
+		return e
+	}
+	for _, certName := range certs {
+		if e := kc.store.Delete(StoreCert, certName); e != nil {
+			return e
+		}
+	}
+	if e := kc.store.Delete(StoreDefault, keyName); e != nil {
+		return e
+	}
+	if e := kc.store.Delete(StoreKey, keyName); e != nil {
+		return e
+	}
+
+	identity := ToSubjectName(keyName)
+	if e := kc.refillDefault(identity, keyName, kc.Keys); e != nil {
+		return e
+	}
+	if _, e := kc.getDefault(identity); !errors.Is(e, ErrNotFound) {
+		return e
+	}
+	return kc.refillDefault(ndn.Name{}, identity, func(ndn.Name) ([]ndn.Name, error) { return kc.Identities() })
+}
+
+// DeleteCert deletes a certificate.
+func (kc *KeyChain) DeleteCert(certName ndn.Name) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+
+	if e := kc.store.Delete(StoreCert, certName); e != nil {
+		return e
+	}
+	return kc.refillDefault(ToKeyName(certName), certName, kc.Certs)
+}
+
+func (kc *KeyChain) getDefault(scope ndn.Name) (name ndn.Name, e error) {
+	value, e := kc.store.Get(StoreDefault, scope)
+	if e != nil {
+		return nil, e
+	}
+	e = name.UnmarshalBinary(value)
+	return name, e
+}
+
+func (kc *KeyChain) setDefault(scope, name ndn.Name) error {
+	value, e := name.MarshalBinary()
+	if e != nil {
+		return e
+	}
+	return kc.store.Put(StoreDefault, scope, value)
+}
+
+// fillDefault makes name the default within scope, if scope does not have a default.
+// If scope is an identity that becomes non-empty, the identity may become the default identity.
+func (kc *KeyChain) fillDefault(scope, name ndn.Name) error {
+	if _, e := kc.getDefault(scope); !errors.Is(e, ErrNotFound) {
+		return e
+	}
+	if e := kc.setDefault(scope, name); e != nil {
+		return e
+	}
+	if IsKeyName(name) {
+		return kc.fillDefault(ndn.Name{}, scope)
+	}
+	return nil
+}
+
+// refillDefault chooses another default within scope, if the current default is deleted.
+func (kc *KeyChain) refillDefault(scope, deleted ndn.Name, list func(scope ndn.Name) ([]ndn.Name, error)) error {
+	current, e := kc.getDefault(scope)
+	if errors.Is(e, ErrNotFound) {
+		current = deleted
+	} else if e != nil {
+		return e
+	}
+	if !current.Equal(deleted) {
+		return nil
+	}
+
+	remaining, e := list(scope)
+	if e != nil {
+		return e
+	}
+	if len(remaining) == 0 {
+		return kc.store.Delete(StoreDefault, scope)
+	}
+	return kc.setDefault(scope, remaining[0])
+}
+
+// DefaultIdentity returns the default identity.
+func (kc *KeyChain) DefaultIdentity() (ndn.Name, error) {
+	return kc.getDefault(ndn.Name{})
+}
+
+// SetDefaultIdentity changes the default identity.
+// The identity must have at least one key.
+func (kc *KeyChain) SetDefaultIdentity(identity ndn.Name) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	if _, e := kc.getDefault(identity); e != nil {
+		return e
+	}
+	return kc.setDefault(ndn.Name{}, identity)
+}
+
+// DefaultKey returns the default key name of an identity.
+func (kc *KeyChain) DefaultKey(identity ndn.Name) (ndn.Name, error) {
+	return kc.getDefault(identity)
+}
+
+// SetDefaultKey changes the default key of its identity.
+func (kc *KeyChain) SetDefaultKey(keyName ndn.Name) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	if _, e := kc.store.Get(StoreKey, keyName); e != nil {
+		return e
+	}
+	return kc.setDefault(ToSubjectName(keyName), keyName)
+}
+
+// DefaultCert returns the default certificate name of a key.
+func (kc *KeyChain) DefaultCert(keyName ndn.Name) (ndn.Name, error) {
+	return kc.getDefault(keyName)
+}
+
+// SetDefaultCert changes the default certificate of its key.
+func (kc *KeyChain) SetDefaultCert(certName ndn.Name) error {
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	if _, e := kc.store.Get(StoreCert, certName); e != nil {
+		return e
+	}
+	return kc.setDefault(ToKeyName(certName), certName)
+}
+
+// resolveKey determines key name and certificate name from a subject name, key name, or certificate name.
+// Empty name refers to the default identity.
+// certName is nil if the key does not have a certificate.
+func (kc *KeyChain) resolveKey(name ndn.Name) (keyName, certName ndn.Name, e error) {
+	switch {
+	case IsCertName(name):
+		return ToKeyName(name), name, nil
+	case IsKeyName(name):
+		keyName = name
+	default:
+		if len(name) == 0 {
+			if name, e = kc.DefaultIdentity(); e != nil {
+				return nil, nil, e
+			}
+		}
+		if keyName, e = kc.DefaultKey(name); e != nil {
+			return nil, nil, e
+		}
+	}
+
+	certName, e = kc.DefaultCert(keyName)
+	if errors.Is(e, ErrNotFound) {
+		return keyName, nil, nil
+	}
+	return keyName, certName, e
+}
+
+// Signer returns a signer of an identity, key, or certificate.
+// name may be a subject name, key name, or certificate name; empty name refers to the default identity.
+// The KeyLocator contains the selected certificate name, or the key name if the key has no certificate.
+//
+// The result may be used as endpoint.ProducerOptions.DataSigner.
+func (kc *KeyChain) Signer(name ndn.Name) (ndn.Signer, error) {
+	keyName, certName, e := kc.resolveKey(name)
+	if e != nil {
+		return nil, e
+	}
+	pvt, e := kc.PrivateKey(keyName)
+	if e != nil {
+		return nil, e
+	}
+	if certName == nil {
+		return pvt, nil
+	}
+	return pvt.WithKeyLocator(certName), nil
+}
+
+// ImportSafeBag imports a private key and its certificate from ndn-cxx exported credentials.
+func (kc *KeyChain) ImportSafeBag(wire, passphrase []byte) (pvt PrivateKey, cert *Certificate, e error) {
+	if pvt, cert, e = ImportSafeBag(wire, passphrase); e != nil {
+		return nil, nil, e
+	}
+
+	kc.mutex.Lock()
+	defer kc.mutex.Unlock()
+	if e := kc.addKey(pvt); e != nil {
+		return nil, nil, e
+	}
+	if e := kc.addCert(cert); e != nil {
+		return nil, nil, e
+	}
+	return pvt, cert, nil
+}
+
+// ExportSafeBag exports a private key and its certificate to ndn-cxx exported credentials.
+// name may be a subject name, key name, or certificate name; empty name refers to the default identity.
+func (kc *KeyChain) ExportSafeBag(name ndn.Name, passphrase []byte) (wire []byte, e error) {
+	keyName, certName, e := kc.resolveKey(name)
+	if e != nil {
+		return nil, e
+	}
+	if certName == nil {
+		return nil, ErrNotFound
+	}
+	pvt, e := kc.PrivateKey(keyName)
+	if e != nil {
+		return nil, e
+	}
+	cert, e := kc.Cert(certName)
+	if e != nil {
+		return nil, e
+	}
+	return ExportSafeBag(pvt, cert, passphrase)
+}
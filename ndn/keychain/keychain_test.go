@@ -0,0 +1,143 @@
+package keychain_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+)
+
+func testKeyChain(t *testing.T, kc *keychain.KeyChain) {
+	assert, require := makeAR(t)
+
+	_, e := kc.DefaultIdentity()
+	assert.ErrorIs(e, keychain.ErrNotFound)
+	_, e = kc.Signer(nil)
+	assert.Error(e)
+
+	pvtA1, certA1, e := kc.CreateKey(ndn.ParseName("/A"), keychain.NewECDSAKeyPair)
+	require.NoError(e)
+	pvtA2, certA2, e := kc.CreateKey(ndn.ParseName("/A"), keychain.NewEd25519KeyPair)
+	require.NoError(e)
+	pvtB1, pubB1, e := keychain.NewECDSAKeyPair(ndn.ParseName("/B"))
+	require.NoError(e)
+	require.NoError(kc.AddKey(pvtB1))
+
+	idents, e := kc.Identities()
+	require.NoError(e)
+	require.Len(idents, 2)
+	nameEqual(assert, "/A", idents[0])
+	nameEqual(assert, "/B", idents[1])
+
+	keysA, e := kc.Keys(ndn.ParseName("/A"))
+	require.NoError(e)
+	assert.Len(keysA, 2)
+
+	defIdent, e := kc.DefaultIdentity()
+	require.NoError(e)
+	nameEqual(assert, "/A", defIdent)
+	defKeyA, e := kc.DefaultKey(ndn.ParseName("/A"))
+	require.NoError(e)
+	nameEqual(assert, pvtA1, defKeyA)
+	defCertA1, e := kc.DefaultCert(pvtA1.Name())
+	require.NoError(e)
+	nameEqual(assert, certA1, defCertA1)
+
+	certB1, e := keychain.MakeCert(pubB1, pvtA1.WithKeyLocator(certA1.Name()), keychain.MakeCertOptions{})
+	require.NoError(e)
+	require.NoError(kc.AddCert(certB1))
+	_, pubX, _ := keychain.NewECDSAKeyPair(ndn.ParseName("/X"))
+	certX, _ := keychain.MakeCert(pubX, pvtA1, keychain.MakeCertOptions{})
+	assert.ErrorIs(kc.AddCert(certX), keychain.ErrNoKey)
+
+	signer, e := kc.Signer(nil)
+	require.NoError(e)
+	var data ndn.Data
+	data.Name = ndn.ParseName("/A/data")
+	require.NoError(signer.Sign(&data))
+	nameEqual(assert, certA1, data.SigInfo.KeyLocator)
+	assert.NoError(certA1.PublicKey().Verify(data))
+
+	require.NoError(kc.SetDefaultKey(pvtA2.Name()))
+	require.NoError(kc.SetDefaultIdentity(ndn.ParseName("/B")))
+	signer, e = kc.Signer(ndn.ParseName("/A"))
+	require.NoError(e)
+	require.NoError(signer.Sign(&data))
+	nameEqual(assert, certA2, data.SigInfo.KeyLocator)
+	signer, e = kc.Signer(nil)
+	require.NoError(e)
+	require.NoError(signer.Sign(&data))
+	nameEqual(assert, certB1, data.SigInfo.KeyLocator)
+	assert.Error(kc.SetDefaultIdentity(ndn.ParseName("/C")))
+
+	safeBag, e := kc.ExportSafeBag(ndn.ParseName("/B"), []byte("passphrase"))
+	require.NoError(e)
+
+	require.NoError(kc.DeleteCert(certA2.Name()))
+	_, e = kc.DefaultCert(pvtA2.Name())
+	assert.ErrorIs(e, keychain.ErrNotFound)
+	require.NoError(kc.DeleteKey(pvtA2.Name()))
+	defKeyA, e = kc.DefaultKey(ndn.ParseName("/A"))
+	require.NoError(e)
+	nameEqual(assert, pvtA1, defKeyA)
+
+	require.NoError(kc.DeleteIdentity(ndn.ParseName("/B")))
+	defIdent, e = kc.DefaultIdentity()
+	require.NoError(e)
+	nameEqual(assert, "/A", defIdent)
+	_, e = kc.PrivateKey(pvtB1.Name())
+	assert.ErrorIs(e, keychain.ErrNotFound)
+
+	_, _, e = kc.ImportSafeBag(safeBag, []byte("passphrase"))
+	require.NoError(e)
+	cert, e := kc.Cert(certB1.Name())
+	require.NoError(e)
+	nameEqual(assert, certB1, cert)
+
+	require.NoError(kc.DeleteIdentity(ndn.ParseName("/A")))
+	require.NoError(kc.DeleteIdentity(ndn.ParseName("/B")))
+	idents, e = kc.Identities()
+	require.NoError(e)
+	assert.Len(idents, 0)
+	_, e = kc.DefaultIdentity()
+	assert.ErrorIs(e, keychain.ErrNotFound)
+}
+
+func TestKeyChainMem(t *testing.T) {
+	testKeyChain(t, keychain.NewKeyChain(keychain.NewMemStore()))
+}
+
+func TestKeyChainDir(t *testing.T) {
+	assert, require := makeAR(t)
+	dir := t.TempDir()
+
+	kc, e := keychain.OpenKeyChain(dir)
+	require.NoError(e)
+	testKeyChain(t, kc)
+
+	pvt, cert, e := kc.CreateKey(ndn.ParseName("/D"), keychain.NewECDSAKeyPair)
+	require.NoError(e)
+
+	keyFiles, e := os.ReadDir(filepath.Join(dir, string(keychain.StoreKey)))
+	require.NoError(e)
+	require.Len(keyFiles, 1)
+	keyInfo, e := keyFiles[0].Info()
+	require.NoError(e)
+	assert.EqualValues(0o600, keyInfo.Mode().Perm())
+
+	kc, e = keychain.OpenKeyChain(dir)
+	require.NoError(e)
+	signer, e := kc.Signer(nil)
+	require.NoError(e)
+	var data ndn.Data
+	data.Name = ndn.ParseName("/D/data")
+	require.NoError(signer.Sign(&data))
+	nameEqual(assert, cert, data.SigInfo.KeyLocator)
+	assert.NoError(cert.PublicKey().Verify(data))
+
+	pvt2, e := kc.PrivateKey(pvt.Name())
+	require.NoError(e)
+	nameEqual(assert, pvt, pvt2)
+}
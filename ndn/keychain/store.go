@@ -0,0 +1,238 @@
+package keychain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// ErrNotFound indicates a requested object does not exist.
+var ErrNotFound = errors.New("not found in keychain")
+
+// StoreKind identifies a kind of objects in a Store.
+type StoreKind string
+
+// StoreKind values.
+const (
+	// StoreKey indicates private keys in MarshalKey format, indexed by key name.
+	StoreKey StoreKind = "key"
+
+	// StoreCert indicates certificates in MarshalCert format, indexed by certificate name.
+	StoreCert StoreKind = "cert"
+
+	// StoreDefault indicates default selections, indexed by scope name.
+	// The value is the TLV-VALUE of the selected name.
+	// The scope is empty name for default identity, subject name for default key, key name for default certificate.
+	StoreDefault StoreKind = "default"
+)
+
+var storeKinds = []StoreKind{StoreKey, StoreCert, StoreDefault}
+
+// Store is a storage backend of KeyChain.
+// Its methods must be safe for concurrent use.
+type Store interface {
+	// List returns names of all objects of a kind, in no particular order.
+	List(kind StoreKind) ([]ndn.Name, error)
+
+	// Get retrieves an object.
+	// Returns ErrNotFound if the object does not exist.
+	Get(kind StoreKind, name ndn.Name) ([]byte, error)
+
+	// Put inserts or replaces an object.
+	Put(kind StoreKind, name ndn.Name, value []byte) error
+
+	// Delete removes an object.
+	// It is not an error if the object does not exist.
+	Delete(kind StoreKind, name ndn.Name) error
+}
+
+func storeKey(name ndn.Name) string {
+	value, _ := name.MarshalBinary()
+	return string(value)
+}
+
+type memStore struct {
+	mutex   sync.RWMutex
+	objects map[StoreKind]map[string][]byte
+}
+
+func (s *memStore) List(kind StoreKind) (names []ndn.Name, e error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	for key := range s.objects[kind] {
+		var name ndn.Name
+		name.UnmarshalBinary([]byte(key))
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (s *memStore) Get(kind StoreKind, name ndn.Name) ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	value, ok := s.objects[kind][storeKey(name)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return value, nil
+}
+
+func (s *memStore) Put(kind StoreKind, name ndn.Name, value []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	m := s.objects[kind]
+	if m == nil {
+		m = map[string][]byte{}
+		s.objects[kind] = m
+	}
+	m[storeKey(name)] = append([]byte{}, value...)
+	return nil
+}
+
+func (s *memStore) Delete(kind StoreKind, name ndn.Name) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.objects[kind], storeKey(name))
+	return nil
+}
+
+// NewMemStore creates an in-memory Store.
+// Its content is lost when the process exits; it is suitable for testing.
+func NewMemStore() Store {
+	return &memStore{
+		objects: map[StoreKind]map[string][]byte{},
+	}
+}
+
+type dirStore struct {
+	mutex sync.Mutex
+	dir   string
+}
+
+func (s *dirStore) filename(kind StoreKind, name ndn.Name) string {
+	h := sha256.Sum256([]byte(storeKey(name)))
+	return filepath.Join(s.dir, string(kind), hex.EncodeToString(h[:]))
+}
+
+func (s *dirStore) List(kind StoreKind) (names []ndn.Name, e error) {
+	entries, e := os.ReadDir(filepath.Join(s.dir, string(kind)))
+	if e != nil {
+		return nil, e
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		wire, e := os.ReadFile(filepath.Join(s.dir, string(kind), entry.Name()))
+		if e != nil {
+			return nil, e
+		}
+		name, _, e := splitDirStoreFile(wire)
+		if e != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (s *dirStore) Get(kind StoreKind, name ndn.Name) ([]byte, error) {
+	wire, e := os.ReadFile(s.filename(kind, name))
+	if errors.Is(e, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if e != nil {
+		return nil, e
+	}
+	storedName, value, e := splitDirStoreFile(wire)
+	if e != nil {
+		return nil, e
+	}
+	if !storedName.Equal(name) {
+		return nil, ErrNotFound
+	}
+	return value, nil
+}
+
+func (s *dirStore) Put(kind StoreKind, name ndn.Name, value []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	perm := fs.FileMode(0o644)
+	if kind == StoreKey {
+		perm = 0o600
+	}
+
+	filename := s.filename(kind, name)
+	tmp, e := os.CreateTemp(filepath.Dir(filename), ".tmp-*")
+	if e != nil {
+		return e
+	}
+	defer os.Remove(tmp.Name())
+
+	if e := tmp.Chmod(perm); e != nil {
+		tmp.Close()
+		return e
+	}
+	nameWire, e := tlv.EncodeFrom(name)
+	if e != nil {
+		tmp.Close()
+		return e
+	}
+	if _, e := tmp.Write(append(nameWire, value...)); e != nil {
+		tmp.Close()
+		return e
+	}
+	if e := tmp.Close(); e != nil {
+		return e
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+func (s *dirStore) Delete(kind StoreKind, name ndn.Name) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if e := os.Remove(s.filename(kind, name)); e != nil && !errors.Is(e, fs.ErrNotExist) {
+		return e
+	}
+	return nil
+}
+
+// splitDirStoreFile splits a file written by dirStore into name and value.
+func splitDirStoreFile(wire []byte) (name ndn.Name, value []byte, e error) {
+	d := tlv.DecodingBuffer(wire)
+	de, e := d.Element()
+	if e != nil {
+		return nil, nil, e
+	}
+	if e = de.UnmarshalValue(&name); e != nil {
+		return nil, nil, e
+	}
+	return name, d.Rest(), nil
+}
+
+// NewDirStore opens or creates a Store in a filesystem directory.
+//
+// The directory contains one subdirectory per StoreKind, in which each object is a file named by
+// hexadecimal SHA-256 digest of its name TLV-VALUE; the file contains the Name TLV followed by the value.
+// Directories are created with 0700 permission.
+// Private key files are written with 0600 permission; other files are written with 0644 permission.
+func NewDirStore(dir string) (Store, error) {
+	dir, e := filepath.Abs(dir)
+	if e != nil {
+		return nil, e
+	}
+	for _, kind := range storeKinds {
+		if e := os.MkdirAll(filepath.Join(dir, string(kind)), 0o700); e != nil {
+			return nil, e
+		}
+	}
+	return &dirStore{dir: dir}, nil
+}
@@ -0,0 +1,95 @@
+package l3
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+)
+
+type csEntry struct {
+	key         string
+	pkt         *ndn.Packet
+	freshExpiry time.Time
+}
+
+func (entry *csEntry) CanSatisfy(interest ndn.Interest, now time.Time) bool {
+	if interest.MustBeFresh && !now.Before(entry.freshExpiry) {
+		return false
+	}
+	return entry.pkt.Data.CanSatisfy(interest)
+}
+
+// contentStore is an in-memory Content Store with LRU replacement.
+type contentStore struct {
+	capacity int
+	table    map[string]*list.Element
+	list     *list.List // Value is *csEntry, ordered by last use
+}
+
+func newContentStore(capacity int) *contentStore {
+	return &contentStore{
+		capacity: capacity,
+		table:    map[string]*list.Element{},
+		list:     list.New(),
+	}
+}
+
+// Find returns a Data packet that can satisfy the Interest.
+func (cs *contentStore) Find(interest ndn.Interest, now time.Time) *ndn.Packet {
+	name := interest.Name
+	if len(name) > 0 && name[len(name)-1].Type == an.TtImplicitSha256DigestComponent {
+		name = name[:len(name)-1]
+	}
+
+	if elem := cs.table[nameKey(name)]; elem != nil {
+		if entry := elem.Value.(*csEntry); entry.CanSatisfy(interest, now) {
+			cs.list.MoveToBack(elem)
+			return entry.pkt
+		}
+	}
+	if !interest.CanBePrefix {
+		return nil
+	}
+
+	for elem := cs.list.Back(); elem != nil; elem = elem.Prev() {
+		if entry := elem.Value.(*csEntry); entry.CanSatisfy(interest, now) {
+			cs.list.MoveToBack(elem)
+			return entry.pkt
+		}
+	}
+	return nil
+}
+
+// Insert adds or replaces a Data packet.
+func (cs *contentStore) Insert(pkt *ndn.Packet, now time.Time) {
+	entry := &csEntry{
+		key:         nameKey(pkt.Data.Name),
+		pkt:         &ndn.Packet{Data: pkt.Data},
+		freshExpiry: now.Add(pkt.Data.Freshness),
+	}
+
+	if elem := cs.table[entry.key]; elem != nil {
+		elem.Value = entry
+		cs.list.MoveToBack(elem)
+		return
+	}
+
+	if cs.list.Len() >= cs.capacity {
+		front := cs.list.Front()
+		delete(cs.table, front.Value.(*csEntry).key)
+		cs.list.Remove(front)
+	}
+	cs.table[entry.key] = cs.list.PushBack(entry)
+}
+
+// Len returns the number of Data packets.
+func (cs *contentStore) Len() int {
+	return cs.list.Len()
+}
+
+func nameKey(name ndn.Name) string {
+	nameV, _ := name.MarshalBinary()
+	return string(nameV)
+}
@@ -11,6 +11,8 @@ package l3
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
@@ -30,6 +32,10 @@ type FaceConfig struct {
 	// Default is MinReassemblerCapacity.
 	ReassemblerCapacity int
 
+	// ReassemblerTimeout is the duration a partial message is kept in the reassembler after its last fragment arrival.
+	// Default is ndn.DefaultLpReassemblerTimeout.
+	ReassemblerTimeout time.Duration
+
 	// RxQueueSize is the Go channel buffer size of RX channel.
 	// Default is DefaultRxQueueSize.
 	RxQueueSize int `json:"rxQueueSize,omitempty"`
@@ -41,6 +47,9 @@ type FaceConfig struct {
 
 func (cfg *FaceConfig) applyDefaults() {
 	cfg.ReassemblerCapacity = generic.Max(cfg.ReassemblerCapacity, MinReassemblerCapacity)
+	if cfg.ReassemblerTimeout <= 0 {
+		cfg.ReassemblerTimeout = ndn.DefaultLpReassemblerTimeout
+	}
 	if cfg.RxQueueSize <= 0 {
 		cfg.RxQueueSize = DefaultRxQueueSize
 	}
@@ -66,10 +75,17 @@ type Face interface {
 
 	State() TransportState
 	OnStateChange(cb func(st TransportState)) (cancel func())
+
+	// ReassemblerCounters returns counters of the NDNLPv2 reassembler.
+	ReassemblerCounters() ndn.LpReassemblerCounters
 }
 
 // NewFace creates a Face.
 // tr.Read() and tr.Write() should not be used after this operation.
+//
+// Outgoing packets larger than tr.MTU() are split into NDNLPv2 fragments.
+// Incoming NDNLPv2 fragments are reassembled; a partial message is discarded upon timeout,
+// or when the reassembler capacity is exceeded.
 func NewFace(tr Transport, cfg FaceConfig) (Face, error) {
 	cfg.applyDefaults()
 	mtu := tr.MTU()
@@ -85,6 +101,7 @@ func NewFace(tr Transport, cfg FaceConfig) (Face, error) {
 		fragmenter:  ndn.NewLpFragmenter(mtu),
 		reassembler: ndn.NewLpReassembler(cfg.ReassemblerCapacity),
 	}
+	f.reassembler.Timeout = cfg.ReassemblerTimeout
 	go f.rxLoop()
 	go f.txLoop()
 	return f, nil
@@ -97,6 +114,7 @@ type face struct {
 
 	mtu         int
 	fragmenter  *ndn.LpFragmenter
+	reassMutex  sync.Mutex
 	reassembler *ndn.LpReassembler
 }
 
@@ -116,6 +134,12 @@ func (f *face) Tx() chan<- ndn.L3Packet {
 	return f.tx
 }
 
+func (f *face) ReassemblerCounters() ndn.LpReassemblerCounters {
+	f.reassMutex.Lock()
+	defer f.reassMutex.Unlock()
+	return f.reassembler.Counters()
+}
+
 func (f *face) rxLoop() {
 	buf := make([]byte, f.mtu)
 	for {
@@ -132,10 +156,15 @@ func (f *face) rxLoop() {
 			continue
 		}
 
-		if pkt.Fragment == nil {
+		switch {
+		case pkt.Fragment == nil && pkt.Interest == nil && pkt.Data == nil && pkt.Nack == nil:
+			// IDLE packet
+		case pkt.Fragment == nil:
 			f.rx <- &pkt
-		} else {
+		default:
+			f.reassMutex.Lock()
 			full, e := f.reassembler.Accept(&pkt)
+			f.reassMutex.Unlock()
 			if e == nil && full != nil {
 				f.rx <- full
 			}
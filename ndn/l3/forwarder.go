@@ -3,52 +3,111 @@ package l3
 import (
 	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/zyedidia/generic/multimap"
-	"github.com/zyedidia/generic/set"
 )
 
 // Forwarder is a logical forwarding plane.
 // Its main purpose is to demultiplex incoming packets among faces, where a 'face' is defined as a duplex stream of packets.
 //
 // This is a simplified forwarder with several limitations.
-//   - There is no loop prevention: no Nonce list and no decrementing HopLimit.
+//   - There is no loop prevention unless PitAggregation is enabled, and HopLimit is not decremented.
 //     If multiple uplinks have "/" route, Interests will be forwarded among them and might cause persistent loops.
 //     Thus, it is not recommended to connect to multiple uplinks with overlapping routes.
-//   - There is no pending Interest table. Instead, downstream 'face' ID is inserted as part of the PIT token.
+//   - Downstream 'face' ID is inserted as part of the PIT token, and Data/Nack are returned according to the PIT token.
 //     Since PIT token cannot exceed 32 octets, this takes away some space.
 //     Thus, consumers are allowed to use a PIT token up to 28 octets; Interests with longer PIT tokens may be dropped.
+//   - Content Store and Interest aggregation are disabled by default, see ForwarderConfig.
 type Forwarder interface {
 	// AddFace adds a Face to the forwarder.
 	// face.Rx() and face.Tx() should not be used after this operation.
 	AddFace(face Face) (FwFace, error)
 
 	// AddReadvertiseDestination adds a destination for prefix announcement.
-	//
-	// Limitations of current implementation:
-	//  - Existing announcements are not advertised on dest.
-	//    Thus, it is recommended to add all readvertise destinations before announcing a prefix.
-	//  - There is no error handling.
+	// Existing announcements are advertised on dest.
+	// Failed operations are retried with exponential backoff, see ForwarderConfig.
 	AddReadvertiseDestination(dest ReadvertiseDestination)
 
 	// RemoveReadvertiseDestination removes a destination for prefix announcement.
-	//
-	// Limitations of current implementation:
-	//  - Announcements are not withdrawn before removing dest.
-	//  - There is no error handling.
+	// Advertised prefixes are withdrawn from dest asynchronously, without retrying.
+	// If dest is added again, AddReadvertiseDestination waits for these withdrawals to complete.
 	RemoveReadvertiseDestination(dest ReadvertiseDestination)
+
+	// ReadvertiseStatus returns per-destination per-prefix readvertise status.
+	ReadvertiseStatus() []ReadvertiseStatus
+}
+
+// ForwarderHooks contains callbacks for collecting forwarder metrics.
+// Each callback is optional. It is invoked on the forwarder goroutine and should return quickly.
+type ForwarderHooks struct {
+	// CsHit is invoked when an Interest is satisfied by the Content Store.
+	CsHit func(interest *ndn.Interest)
+
+	// CsMiss is invoked when an Interest cannot be satisfied by the Content Store.
+	CsMiss func(interest *ndn.Interest)
+
+	// PitAggregate is invoked when an Interest is aggregated into an existing pending Interest.
+	PitAggregate func(interest *ndn.Interest)
+
+	// PitLoop is invoked when an Interest is dropped because its Nonce duplicates a pending Interest.
+	PitLoop func(interest *ndn.Interest)
+
+	// PitSatisfy is invoked when a Data packet satisfies a pending Interest.
+	// nDownstreams is the number of unexpired downstreams receiving the Data.
+	PitSatisfy func(data *ndn.Data, nDownstreams int)
 }
 
-// NewForwarder creates a Forwarder.
+// ForwarderConfig contains options for NewForwarderWithConfig.
+type ForwarderConfig struct {
+	// CsCapacity is the maximum number of Data packets in the Content Store.
+	// When full, the least recently used Data is evicted.
+	// Data is cached only if it satisfies a pending Interest; if PitAggregation is disabled,
+	// this means its PIT token refers to an existing downstream face.
+	// Default is zero, which disables the Content Store.
+	CsCapacity int
+
+	// PitAggregation enables aggregation of pending Interests.
+	// If enabled, an Interest with the same Name, CanBePrefix, and MustBeFresh as a pending Interest is not forwarded,
+	// and the returned Data or Nack is delivered to all downstreams.
+	// An Interest whose Nonce duplicates a pending Interest is dropped as a looping Interest.
+	PitAggregation bool
+
+	// Hooks contains callbacks for collecting forwarder metrics.
+	Hooks ForwarderHooks
+
+	// ReadvertiseRetryInitial is the initial retry interval after a failed readvertise operation.
+	// Default is DefaultReadvertiseRetryInitial.
+	ReadvertiseRetryInitial time.Duration
+
+	// ReadvertiseRetryMax is the maximum retry interval after consecutive failed readvertise operations.
+	// Default is DefaultReadvertiseRetryMax.
+	ReadvertiseRetryMax time.Duration
+}
+
+// NewForwarder creates a Forwarder without Content Store or Interest aggregation.
 func NewForwarder() Forwarder {
+	return NewForwarderWithConfig(ForwarderConfig{})
+}
+
+// NewForwarderWithConfig creates a Forwarder.
+func NewForwarderWithConfig(cfg ForwarderConfig) Forwarder {
 	fw := &forwarder{
+		cfg:           cfg,
 		faces:         map[uint32]*fwFace{},
 		announcements: multimap.NewMapSlice[string, *fwFace](),
-		readvertise:   set.NewMapset[ReadvertiseDestination](),
+		readvertise:   map[ReadvertiseDestination]*rdvDest{},
+		rdvRemoving:   map[ReadvertiseDestination]<-chan struct{}{},
 		cmd:           make(chan func()),
 		rx:            make(chan fwRxPkt),
 	}
+	if cfg.CsCapacity > 0 {
+		fw.cs = newContentStore(cfg.CsCapacity)
+	}
+	if cfg.PitAggregation {
+		fw.pit = newPendingInterestTable()
+	}
 	go fw.loop()
 	return fw
 }
@@ -59,9 +118,13 @@ type fwRxPkt struct {
 }
 
 type forwarder struct {
+	cfg           ForwarderConfig
+	cs            *contentStore
+	pit           *pendingInterestTable
 	faces         map[uint32]*fwFace
 	announcements multimap.MultiMap[string, *fwFace]
-	readvertise   set.Set[ReadvertiseDestination]
+	readvertise   map[ReadvertiseDestination]*rdvDest
+	rdvRemoving   map[ReadvertiseDestination]<-chan struct{}
 	cmd           chan func()
 	rx            chan fwRxPkt
 }
@@ -96,15 +159,61 @@ func (fw *forwarder) AddFace(face Face) (ff FwFace, e error) {
 }
 
 func (fw *forwarder) AddReadvertiseDestination(dest ReadvertiseDestination) {
+	for {
+		var removing <-chan struct{}
+		fw.do(func() {
+			if removing = fw.rdvRemoving[dest]; removing != nil {
+				select {
+				case <-removing:
+					delete(fw.rdvRemoving, dest)
+					removing = nil
+				default:
+					return
+				}
+			}
+
+			if fw.readvertise[dest] != nil {
+				return
+			}
+			rd := newRdvDest(dest, fw.cfg)
+			fw.readvertise[dest] = rd
+			fw.announcements.EachAssociation(func(nameS string, faces []*fwFace) {
+				rd.Set(nameS, faces[0].announcements[nameS], true)
+			})
+		})
+
+		if removing == nil {
+			return
+		}
+		// wait for withdrawals from previous removal outside forwarder goroutine
+		<-removing
+	}
+}
+
+func (fw *forwarder) RemoveReadvertiseDestination(dest ReadvertiseDestination) {
 	fw.do(func() {
-		fw.readvertise.Put(dest)
+		for d, removing := range fw.rdvRemoving {
+			select {
+			case <-removing:
+				delete(fw.rdvRemoving, d)
+			default:
+			}
+		}
+
+		if rd := fw.readvertise[dest]; rd != nil {
+			delete(fw.readvertise, dest)
+			fw.rdvRemoving[dest] = rd.Remove()
+		}
 	})
 }
 
-func (fw *forwarder) RemoveReadvertiseDestination(dest ReadvertiseDestination) {
+func (fw *forwarder) ReadvertiseStatus() (list []ReadvertiseStatus) {
 	fw.do(func() {
-		fw.readvertise.Remove(dest)
+		for _, rd := range fw.readvertise {
+			list = append(list, rd.Status()...)
+		}
 	})
+	return list
 }
 
 func (fw *forwarder) do(fn func()) {
@@ -117,10 +226,19 @@ func (fw *forwarder) do(fn func()) {
 }
 
 func (fw *forwarder) loop() {
+	var pitExpire <-chan time.Time
+	if fw.pit != nil {
+		ticker := time.NewTicker(pitExpireInterval)
+		defer ticker.Stop()
+		pitExpire = ticker.C
+	}
+
 	for {
 		select {
 		case fn := <-fw.cmd:
 			fn()
+		case now := <-pitExpire:
+			fw.pit.Expire(now)
 		case pkt := <-fw.rx:
 			switch {
 			case pkt.Interest != nil:
@@ -133,6 +251,22 @@ func (fw *forwarder) loop() {
 }
 
 func (fw *forwarder) forwardInterest(pkt fwRxPkt) {
+	now := time.Now()
+	if fw.cs != nil {
+		if found := fw.cs.Find(*pkt.Interest, now); found != nil {
+			if fw.cfg.Hooks.CsHit != nil {
+				fw.cfg.Hooks.CsHit(pkt.Interest)
+			}
+			reply := *found
+			_, reply.Lp.PitToken = tokenStripID(pkt.Lp.PitToken)
+			pkt.rxFace.tx <- &reply
+			return
+		}
+		if fw.cfg.Hooks.CsMiss != nil {
+			fw.cfg.Hooks.CsMiss(pkt.Interest)
+		}
+	}
+
 	lpmLen := 0
 	var nexthops []*fwFace
 	for _, f := range fw.faces {
@@ -150,6 +284,31 @@ func (fw *forwarder) forwardInterest(pkt fwRxPkt) {
 			nexthops = append(nexthops, f)
 		}
 	}
+	if len(nexthops) == 0 {
+		// no PIT entry is created, so that later Interests are not aggregated into an Interest that is never forwarded
+		return
+	}
+
+	if fw.pit != nil {
+		entry, res := fw.pit.Insert(pkt.Packet, now)
+		switch res {
+		case pitInsertLoop:
+			if fw.cfg.Hooks.PitLoop != nil {
+				fw.cfg.Hooks.PitLoop(pkt.Interest)
+			}
+			return
+		case pitInsertAggregated:
+			if fw.cfg.Hooks.PitAggregate != nil {
+				fw.cfg.Hooks.PitAggregate(pkt.Interest)
+			}
+			return
+		case pitInsertRetx:
+			// forward with the PIT token of the first Interest, so that Data can match the PIT entry
+			fwd := *pkt.Packet
+			fwd.Lp.PitToken = []byte(entry.upToken)
+			pkt.Packet = &fwd
+		}
+	}
 
 	for _, f := range nexthops {
 		f.tx <- pkt
@@ -157,13 +316,46 @@ func (fw *forwarder) forwardInterest(pkt fwRxPkt) {
 }
 
 func (fw *forwarder) forwardDataNack(pkt fwRxPkt) {
+	now := time.Now()
+	if fw.pit != nil {
+		if entry := fw.pit.Find(pkt.Lp.PitToken); entry != nil {
+			fw.pit.Erase(entry)
+			var downstreams []pitDownstream
+			for _, dn := range entry.downstreams {
+				if fw.faces[dn.faceID] != nil && now.Before(dn.expiry) {
+					downstreams = append(downstreams, dn)
+				}
+			}
+
+			if pkt.Data != nil {
+				if fw.cs != nil {
+					fw.cs.Insert(pkt.Packet, now)
+				}
+				if fw.cfg.Hooks.PitSatisfy != nil {
+					fw.cfg.Hooks.PitSatisfy(pkt.Data, len(downstreams))
+				}
+			}
+			for _, dn := range downstreams {
+				reply := *pkt.Packet
+				reply.Lp.PitToken = dn.token
+				fw.faces[dn.faceID].tx <- &reply
+			}
+			return
+		}
+	}
+
 	var id uint32
 	id, pkt.Lp.PitToken = tokenStripID(pkt.Lp.PitToken)
 	if f := fw.faces[id]; f != nil {
+		if fw.cs != nil && fw.pit == nil && pkt.Data != nil {
+			fw.cs.Insert(pkt.Packet, now)
+		}
 		f.tx <- pkt.Packet
 	}
 }
 
+const pitExpireInterval = 100 * time.Millisecond
+
 var (
 	defaultForwarder     Forwarder
 	defaultForwarderOnce sync.Once
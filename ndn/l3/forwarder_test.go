@@ -0,0 +1,315 @@
+package l3_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/core/testenv"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/ndntestenv"
+	"go4.org/must"
+)
+
+var (
+	makeAR    = testenv.MakeAR
+	nameEqual = ndntestenv.NameEqual
+)
+
+type fwFixture struct {
+	Fw        l3.Forwarder
+	NProduced atomic.Int32
+}
+
+func (f *fwFixture) Produce(t testing.TB, ctx context.Context) {
+	_, require := makeAR(t)
+	p, e := endpoint.Produce(ctx, endpoint.ProducerOptions{
+		Prefix: ndn.ParseName("/P"),
+		Fw:     f.Fw,
+		Handler: func(ctx context.Context, interest ndn.Interest) (ndn.Data, error) {
+			f.NProduced.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			return ndn.MakeData(interest, []byte{0xC0}), nil
+		},
+	})
+	require.NoError(e)
+	t.Cleanup(func() { must.Close(p) })
+}
+
+func (f *fwFixture) ConsumeConcurrently(ctx context.Context, n int, args ...any) (nOk int) {
+	var wg sync.WaitGroup
+	var nOk32 atomic.Int32
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, e := endpoint.Consume(ctx, ndn.MakeInterest(args...), endpoint.ConsumerOptions{Fw: f.Fw}); e == nil {
+				nOk32.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	return int(nOk32.Load())
+}
+
+func TestForwarderDefault(t *testing.T) {
+	assert, _ := makeAR(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var f fwFixture
+	f.Fw = l3.NewForwarder()
+	f.Produce(t, ctx)
+
+	assert.Equal(3, f.ConsumeConcurrently(ctx, 3, "/P/1"))
+	assert.EqualValues(3, f.NProduced.Load())
+}
+
+func TestForwarderCsPit(t *testing.T) {
+	assert, _ := makeAR(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var nCsHit, nCsMiss, nAggregate, nSatisfyDownstreams atomic.Int32
+	var f fwFixture
+	f.Fw = l3.NewForwarderWithConfig(l3.ForwarderConfig{
+		CsCapacity:     2,
+		PitAggregation: true,
+		Hooks: l3.ForwarderHooks{
+			CsHit:        func(*ndn.Interest) { nCsHit.Add(1) },
+			CsMiss:       func(*ndn.Interest) { nCsMiss.Add(1) },
+			PitAggregate: func(*ndn.Interest) { nAggregate.Add(1) },
+			PitSatisfy:   func(_ *ndn.Data, n int) { nSatisfyDownstreams.Add(int32(n)) },
+		},
+	})
+	f.Produce(t, ctx)
+
+	// identical Interests are aggregated
+	assert.Equal(3, f.ConsumeConcurrently(ctx, 3, "/P/1"))
+	assert.EqualValues(1, f.NProduced.Load())
+	assert.EqualValues(2, nAggregate.Load())
+	assert.EqualValues(3, nSatisfyDownstreams.Load())
+
+	// Data is served from CS
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P/1"))
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P", ndn.CanBePrefixFlag))
+	assert.EqualValues(1, f.NProduced.Load())
+	assert.EqualValues(2, nCsHit.Load())
+
+	// CS cannot satisfy MustBeFresh, because Data has zero FreshnessPeriod
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P/1", ndn.MustBeFreshFlag))
+	assert.EqualValues(2, f.NProduced.Load())
+
+	// LRU eviction
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P/2"))
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P/3"))
+	assert.EqualValues(4, f.NProduced.Load())
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P/1"))
+	assert.EqualValues(5, f.NProduced.Load())
+	assert.EqualValues(2, nCsHit.Load())
+	assert.EqualValues(7, nCsMiss.Load())
+}
+
+func TestForwarderUnsolicited(t *testing.T) {
+	assert, require := makeAR(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var nCsHit, nCsMiss atomic.Int32
+	var f fwFixture
+	f.Fw = l3.NewForwarderWithConfig(l3.ForwarderConfig{
+		CsCapacity:     2,
+		PitAggregation: true,
+		Hooks: l3.ForwarderHooks{
+			CsHit:  func(*ndn.Interest) { nCsHit.Add(1) },
+			CsMiss: func(*ndn.Interest) { nCsMiss.Add(1) },
+		},
+	})
+
+	// unsolicited Data is not cached
+	face, e := endpoint.NewLFace(f.Fw)
+	require.NoError(e)
+	defer must.Close(face)
+	data := ndn.MakeData("/P/1", []byte{0xC1})
+	face.Send(data.ToPacket())
+	time.Sleep(50 * time.Millisecond)
+
+	// Interest without route does not leave a PIT entry
+	noRoute, noRouteCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer noRouteCancel()
+	assert.Equal(0, f.ConsumeConcurrently(noRoute, 1, "/P/1", 150*time.Millisecond))
+	assert.EqualValues(0, nCsHit.Load())
+	assert.EqualValues(1, nCsMiss.Load())
+
+	// Interest is forwarded after a route is added
+	f.Produce(t, ctx)
+	assert.Equal(1, f.ConsumeConcurrently(ctx, 1, "/P/1"))
+	assert.EqualValues(1, f.NProduced.Load())
+	assert.EqualValues(0, nCsHit.Load())
+}
+
+type readvertiseDestinationMock struct {
+	mutex         sync.Mutex
+	nFailures     int
+	withdrawDelay time.Duration
+	advertised    map[string]int
+	withdrawn     map[string]int
+	ops           []string
+}
+
+func (dest *readvertiseDestinationMock) Advertise(name ndn.Name) error {
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
+	if dest.nFailures > 0 {
+		dest.nFailures--
+		return errors.New("mock failure")
+	}
+	dest.advertised[name.String()]++
+	dest.ops = append(dest.ops, "advertise "+name.String())
+	return nil
+}
+
+func (dest *readvertiseDestinationMock) Withdraw(name ndn.Name) error {
+	time.Sleep(dest.withdrawDelay)
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
+	dest.withdrawn[name.String()]++
+	dest.ops = append(dest.ops, "withdraw "+name.String())
+	return nil
+}
+
+func (dest *readvertiseDestinationMock) Ops() []string {
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
+	return append([]string{}, dest.ops...)
+}
+
+func (dest *readvertiseDestinationMock) Counts(name string) (nAdvertised, nWithdrawn int) {
+	dest.mutex.Lock()
+	defer dest.mutex.Unlock()
+	return dest.advertised[name], dest.withdrawn[name]
+}
+
+func newReadvertiseDestinationMock(nFailures int) *readvertiseDestinationMock {
+	return &readvertiseDestinationMock{
+		nFailures:  nFailures,
+		advertised: map[string]int{},
+		withdrawn:  map[string]int{},
+	}
+}
+
+func TestReadvertise(t *testing.T) {
+	assert, require := makeAR(t)
+	fw := l3.NewForwarderWithConfig(l3.ForwarderConfig{
+		ReadvertiseRetryInitial: 50 * time.Millisecond,
+		ReadvertiseRetryMax:     100 * time.Millisecond,
+	})
+
+	produce := func(prefix string) endpoint.Producer {
+		p, e := endpoint.Produce(context.Background(), endpoint.ProducerOptions{
+			Prefix: ndn.ParseName(prefix),
+			Fw:     fw,
+			Handler: func(ctx context.Context, interest ndn.Interest) (ndn.Data, error) {
+				return ndn.Data{}, errors.New("unused")
+			},
+		})
+		require.NoError(e)
+		return p
+	}
+	pA := produce("/A")
+	defer must.Close(pA)
+	time.Sleep(50 * time.Millisecond)
+
+	// existing announcement is advertised on new destination
+	dest1 := newReadvertiseDestinationMock(0)
+	fw.AddReadvertiseDestination(dest1)
+	time.Sleep(50 * time.Millisecond)
+	nAdv, _ := dest1.Counts("/8=A")
+	assert.Equal(1, nAdv)
+
+	// failed advertisement is retried with backoff
+	dest2 := newReadvertiseDestinationMock(3)
+	fw.AddReadvertiseDestination(dest2)
+	time.Sleep(80 * time.Millisecond)
+	status := fw.ReadvertiseStatus()
+	require.Len(status, 2)
+	for _, st := range status {
+		nameEqual(assert, "/A", st.Prefix)
+		switch st.Dest {
+		case dest1:
+			assert.Equal(l3.ReadvertiseAdvertised, st.State)
+			assert.NoError(st.Error)
+		case dest2:
+			assert.Equal(l3.ReadvertisePending, st.State)
+			assert.Error(st.Error)
+			assert.GreaterOrEqual(st.NFailures, 1)
+			assert.False(st.RetryAt.IsZero())
+		default:
+			assert.Fail("unexpected Dest")
+		}
+	}
+
+	time.Sleep(400 * time.Millisecond)
+	nAdv, _ = dest2.Counts("/8=A")
+	assert.Equal(1, nAdv)
+	for _, st := range fw.ReadvertiseStatus() {
+		assert.Equal(l3.ReadvertiseAdvertised, st.State)
+		assert.Zero(st.NFailures)
+	}
+
+	// new announcement is advertised on all destinations
+	pB := produce("/B")
+	time.Sleep(50 * time.Millisecond)
+	nAdv, _ = dest1.Counts("/8=B")
+	assert.Equal(1, nAdv)
+	nAdv, _ = dest2.Counts("/8=B")
+	assert.Equal(1, nAdv)
+	assert.Len(fw.ReadvertiseStatus(), 4)
+
+	// closed producer is withdrawn
+	must.Close(pB)
+	time.Sleep(50 * time.Millisecond)
+	_, nWd := dest1.Counts("/8=B")
+	assert.Equal(1, nWd)
+	assert.Len(fw.ReadvertiseStatus(), 2)
+
+	// removed destination is withdrawn
+	fw.RemoveReadvertiseDestination(dest2)
+	time.Sleep(50 * time.Millisecond)
+	_, nWd = dest2.Counts("/8=A")
+	assert.Equal(1, nWd)
+	_, nWd = dest1.Counts("/8=A")
+	assert.Equal(0, nWd)
+	assert.Len(fw.ReadvertiseStatus(), 1)
+}
+
+func TestReadvertiseReAdd(t *testing.T) {
+	assert, require := makeAR(t)
+	fw := l3.NewForwarder()
+
+	p, e := endpoint.Produce(context.Background(), endpoint.ProducerOptions{
+		Prefix: ndn.ParseName("/A"),
+		Fw:     fw,
+		Handler: func(ctx context.Context, interest ndn.Interest) (ndn.Data, error) {
+			return ndn.Data{}, errors.New("unused")
+		},
+	})
+	require.NoError(e)
+	defer must.Close(p)
+
+	dest := newReadvertiseDestinationMock(0)
+	dest.withdrawDelay = 100 * time.Millisecond
+	fw.AddReadvertiseDestination(dest)
+	time.Sleep(50 * time.Millisecond)
+
+	// re-adding waits for withdrawals from previous removal
+	fw.RemoveReadvertiseDestination(dest)
+	fw.AddReadvertiseDestination(dest)
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal([]string{"advertise /8=A", "withdraw /8=A", "advertise /8=A"}, dest.Ops())
+}
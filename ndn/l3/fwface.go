@@ -100,10 +100,9 @@ func (f *fwFace) AddAnnouncement(name ndn.Name) {
 		f.announcements[nameS] = name
 
 		if !f.fw.announcements.Has(nameS) {
-			f.fw.readvertise.Each(func(dest ReadvertiseDestination) {
-				go dest.Advertise(name)
-
-			})
+			for _, rd := range f.fw.readvertise {
+				rd.Set(nameS, name, true)
+			}
 		}
 		f.fw.announcements.Put(nameS, f)
 	})
@@ -122,9 +121,9 @@ func (f *fwFace) removeAnnouncementImpl(name ndn.Name, nameS string) {
 
 	f.fw.announcements.Remove(nameS, f)
 	if !f.fw.announcements.Has(nameS) {
-		f.fw.readvertise.Each(func(dest ReadvertiseDestination) {
-			go dest.Withdraw(name)
-		})
+		for _, rd := range f.fw.readvertise {
+			rd.Set(nameS, name, false)
+		}
 	}
 }
 
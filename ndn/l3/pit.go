@@ -0,0 +1,134 @@
+package l3
+
+import (
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"golang.org/x/exp/slices"
+)
+
+type pitDownstream struct {
+	faceID uint32
+	token  []byte // PIT token from downstream, without face ID
+	expiry time.Time
+}
+
+type pitEntry struct {
+	key         string
+	upToken     string // PIT token of the forwarded Interest, with face ID
+	downstreams []pitDownstream
+	nonces      []ndn.Nonce
+	expiry      time.Time
+}
+
+// pendingInterestTable aggregates pending Interests with the same Name, CanBePrefix, and MustBeFresh.
+type pendingInterestTable struct {
+	byKey   map[string]*pitEntry
+	byToken map[string]*pitEntry
+}
+
+func newPendingInterestTable() *pendingInterestTable {
+	return &pendingInterestTable{
+		byKey:   map[string]*pitEntry{},
+		byToken: map[string]*pitEntry{},
+	}
+}
+
+func pitKey(interest ndn.Interest) string {
+	key := []byte(nameKey(interest.Name))
+	if interest.CanBePrefix {
+		key = append(key, 'P')
+	}
+	if interest.MustBeFresh {
+		key = append(key, 'F')
+	}
+	return string(key)
+}
+
+type pitInsertResult int
+
+const (
+	pitInsertNew        pitInsertResult = iota // new PIT entry, forward the Interest
+	pitInsertRetx                              // retransmission from existing downstream, forward again
+	pitInsertAggregated                        // new downstream of existing PIT entry, do not forward
+	pitInsertLoop                              // duplicate Nonce, drop the Interest
+)
+
+// Insert records an incoming Interest, whose PIT token already contains the downstream face ID.
+func (pit *pendingInterestTable) Insert(pkt *ndn.Packet, now time.Time) (entry *pitEntry, res pitInsertResult) {
+	interest := *pkt.Interest
+	lifetime := interest.Lifetime
+	if lifetime == 0 {
+		lifetime = ndn.DefaultInterestLifetime
+	}
+	faceID, token := tokenStripID(pkt.Lp.PitToken)
+	dn := pitDownstream{
+		faceID: faceID,
+		token:  token,
+		expiry: now.Add(lifetime),
+	}
+
+	key := pitKey(interest)
+	entry = pit.byKey[key]
+	if entry != nil && !now.Before(entry.expiry) {
+		pit.Erase(entry)
+		entry = nil
+	}
+
+	isNew := entry == nil
+	if isNew {
+		entry = &pitEntry{
+			key:     key,
+			upToken: string(pkt.Lp.PitToken),
+		}
+		pit.byKey[key] = entry
+		pit.byToken[entry.upToken] = entry
+	} else if !interest.Nonce.IsZero() && slices.Contains(entry.nonces, interest.Nonce) {
+		return entry, pitInsertLoop
+	}
+
+	if !interest.Nonce.IsZero() {
+		entry.nonces = append(entry.nonces, interest.Nonce)
+	}
+	if i := slices.IndexFunc(entry.downstreams, func(d pitDownstream) bool {
+		return d.faceID == dn.faceID && string(d.token) == string(dn.token)
+	}); i >= 0 {
+		entry.downstreams[i] = dn
+		res = pitInsertRetx
+	} else {
+		entry.downstreams = append(entry.downstreams, dn)
+		res = pitInsertAggregated
+	}
+	if isNew {
+		res = pitInsertNew
+	}
+	if dn.expiry.After(entry.expiry) {
+		entry.expiry = dn.expiry
+	}
+	return entry, res
+}
+
+// Find returns the PIT entry matching the PIT token of a Data or Nack.
+func (pit *pendingInterestTable) Find(token []byte) *pitEntry {
+	return pit.byToken[string(token)]
+}
+
+// Erase deletes a PIT entry.
+func (pit *pendingInterestTable) Erase(entry *pitEntry) {
+	delete(pit.byKey, entry.key)
+	delete(pit.byToken, entry.upToken)
+}
+
+// Expire deletes expired PIT entries.
+func (pit *pendingInterestTable) Expire(now time.Time) {
+	for _, entry := range pit.byKey {
+		if !now.Before(entry.expiry) {
+			pit.Erase(entry)
+		}
+	}
+}
+
+// Len returns the number of PIT entries.
+func (pit *pendingInterestTable) Len() int {
+	return len(pit.byKey)
+}
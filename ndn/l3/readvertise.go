@@ -1,6 +1,13 @@
 package l3
 
-import "github.com/usnistgov/ndn-dpdk/ndn"
+import (
+	"sync"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/zyedidia/generic"
+	"golang.org/x/exp/maps"
+)
 
 // ReadvertiseDestination represents a destination of name advertisement.
 //
@@ -11,3 +18,252 @@ type ReadvertiseDestination interface {
 
 	Withdraw(name ndn.Name) error
 }
+
+// ReadvertiseState indicates the state of a prefix on a readvertise destination.
+type ReadvertiseState int
+
+// ReadvertiseState values.
+const (
+	// ReadvertisePending means the prefix is being advertised.
+	ReadvertisePending ReadvertiseState = iota
+	// ReadvertiseAdvertised means the prefix has been advertised successfully.
+	ReadvertiseAdvertised
+	// ReadvertiseWithdrawing means the prefix is being withdrawn.
+	ReadvertiseWithdrawing
+)
+
+func (st ReadvertiseState) String() string {
+	switch st {
+	case ReadvertisePending:
+		return "pending"
+	case ReadvertiseAdvertised:
+		return "advertised"
+	case ReadvertiseWithdrawing:
+		return "withdrawing"
+	}
+	return "unknown"
+}
+
+// ReadvertiseStatus describes the state of a prefix on a readvertise destination.
+type ReadvertiseStatus struct {
+	Dest   ReadvertiseDestination
+	Prefix ndn.Name
+	State  ReadvertiseState
+
+	// Error is the error of the last failed operation, or nil if the last operation succeeded.
+	Error error
+	// NFailures is the number of consecutive failures.
+	NFailures int
+	// RetryAt is the time of next retry after a failure.
+	RetryAt time.Time
+}
+
+// Readvertise retry defaults.
+const (
+	DefaultReadvertiseRetryInitial = time.Second
+	DefaultReadvertiseRetryMax     = 60 * time.Second
+)
+
+type rdvEntry struct {
+	ReadvertiseStatus
+	want       bool // whether the prefix should be advertised
+	advertised bool // whether the prefix is currently advertised
+}
+
+// rdvDest serializes advertise and withdraw operations on a readvertise destination.
+type rdvDest struct {
+	dest         ReadvertiseDestination
+	retryInitial time.Duration
+	retryMax     time.Duration
+	mutex        sync.Mutex
+	entries      map[string]*rdvEntry
+	wake         chan struct{}
+	removing     chan struct{}
+	removed      chan struct{}
+}
+
+func newRdvDest(dest ReadvertiseDestination, cfg ForwarderConfig) *rdvDest {
+	rd := &rdvDest{
+		dest:         dest,
+		retryInitial: cfg.ReadvertiseRetryInitial,
+		retryMax:     cfg.ReadvertiseRetryMax,
+		entries:      map[string]*rdvEntry{},
+		wake:         make(chan struct{}, 1),
+		removing:     make(chan struct{}),
+		removed:      make(chan struct{}),
+	}
+	if rd.retryInitial <= 0 {
+		rd.retryInitial = DefaultReadvertiseRetryInitial
+	}
+	if rd.retryMax <= 0 {
+		rd.retryMax = DefaultReadvertiseRetryMax
+	}
+	go rd.loop()
+	return rd
+}
+
+// Set changes whether a prefix should be advertised.
+func (rd *rdvDest) Set(nameS string, name ndn.Name, want bool) {
+	rd.mutex.Lock()
+	defer rd.mutex.Unlock()
+
+	entry := rd.entries[nameS]
+	switch {
+	case entry == nil && !want:
+		return
+	case entry == nil:
+		entry = &rdvEntry{}
+		entry.Dest, entry.Prefix = rd.dest, name
+		rd.entries[nameS] = entry
+	case entry.want == want:
+		return
+	}
+
+	entry.want = want
+	entry.Error, entry.NFailures, entry.RetryAt = nil, 0, time.Time{}
+	entry.updateState()
+	rd.notify()
+}
+
+// Remove withdraws all prefixes and stops the destination.
+// Returns a channel that is closed when withdrawals have completed.
+func (rd *rdvDest) Remove() <-chan struct{} {
+	close(rd.removing)
+	return rd.removed
+}
+
+// Status returns status of all prefixes.
+func (rd *rdvDest) Status() (list []ReadvertiseStatus) {
+	rd.mutex.Lock()
+	defer rd.mutex.Unlock()
+	for _, entry := range rd.entries {
+		list = append(list, entry.ReadvertiseStatus)
+	}
+	return list
+}
+
+func (rd *rdvDest) notify() {
+	select {
+	case rd.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (entry *rdvEntry) updateState() {
+	switch {
+	case entry.want && entry.advertised:
+		entry.State = ReadvertiseAdvertised
+	case entry.want:
+		entry.State = ReadvertisePending
+	default:
+		entry.State = ReadvertiseWithdrawing
+	}
+}
+
+func (rd *rdvDest) loop() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-rd.removing:
+			rd.withdrawAll()
+			close(rd.removed)
+			return
+		case <-rd.wake:
+		case <-timer.C:
+		}
+
+		nextRetry := rd.processOnce()
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		if !nextRetry.IsZero() {
+			timer.Reset(time.Until(nextRetry))
+		}
+	}
+}
+
+// processOnce performs pending operations that are due.
+// Returns the earliest time of a scheduled retry, or zero if there is none.
+func (rd *rdvDest) processOnce() (nextRetry time.Time) {
+	for {
+		rd.mutex.Lock()
+		var entry *rdvEntry
+		var nameS string
+		now := time.Now()
+		nextRetry = time.Time{}
+		for key, ent := range rd.entries {
+			if ent.want == ent.advertised {
+				if !ent.want {
+					delete(rd.entries, key)
+				}
+				continue
+			}
+			if ent.RetryAt.After(now) {
+				if nextRetry.IsZero() || ent.RetryAt.Before(nextRetry) {
+					nextRetry = ent.RetryAt
+				}
+				continue
+			}
+			entry, nameS = ent, key
+			break
+		}
+		if entry == nil {
+			rd.mutex.Unlock()
+			return nextRetry
+		}
+		want, name := entry.want, entry.Prefix
+		rd.mutex.Unlock()
+
+		var e error
+		if want {
+			e = rd.dest.Advertise(name)
+		} else {
+			e = rd.dest.Withdraw(name)
+		}
+
+		rd.mutex.Lock()
+		switch {
+		case rd.entries[nameS] != entry:
+		case e == nil:
+			entry.advertised = want
+			if entry.want == want {
+				entry.Error, entry.NFailures, entry.RetryAt = nil, 0, time.Time{}
+			}
+			if !entry.want && !entry.advertised {
+				delete(rd.entries, nameS)
+			}
+		case entry.want == want:
+			entry.Error = e
+			entry.NFailures++
+			entry.RetryAt = time.Now().Add(rd.backoff(entry.NFailures))
+		}
+		entry.updateState()
+		rd.mutex.Unlock()
+	}
+}
+
+func (rd *rdvDest) backoff(nFailures int) time.Duration {
+	d := rd.retryInitial
+	for i := 1; i < nFailures && d < rd.retryMax; i++ {
+		d *= 2
+	}
+	return generic.Min(d, rd.retryMax)
+}
+
+// withdrawAll withdraws advertised prefixes once, without retrying.
+func (rd *rdvDest) withdrawAll() {
+	rd.mutex.Lock()
+	entries := maps.Values(rd.entries)
+	rd.entries = map[string]*rdvEntry{}
+	rd.mutex.Unlock()
+
+	for _, entry := range entries {
+		if entry.advertised {
+			rd.dest.Withdraw(entry.Prefix)
+		}
+	}
+}
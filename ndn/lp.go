@@ -2,13 +2,15 @@ package ndn
 
 import (
 	"bytes"
+	"container/list"
 	"encoding/binary"
 	"math/rand"
 	"strconv"
+	"time"
 
 	"github.com/usnistgov/ndn-dpdk/ndn/an"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
-	"github.com/zyedidia/generic/cache"
+	"github.com/zyedidia/generic"
 )
 
 func lpIsCritical(typ uint32) bool {
@@ -28,11 +30,20 @@ type LpL3 struct {
 	PitToken   []byte
 	NackReason uint8
 	CongMark   uint8
+
+	// NextHopFaceID requests the forwarder to send an Interest to this face, bypassing the strategy.
+	// It is honored on Interests received from local faces. Zero means absent.
+	NextHopFaceID uint64
+
+	// IncomingFaceID indicates the face from which the forwarder received the packet.
+	// It is attached on packets delivered to local faces. Zero means absent.
+	IncomingFaceID uint64
 }
 
 // Empty returns true if LpL3 has zero fields.
 func (lph LpL3) Empty() bool {
-	return len(lph.PitToken) == 0 && lph.NackReason == an.NackNone && lph.CongMark == 0
+	return len(lph.PitToken) == 0 && lph.NackReason == an.NackNone && lph.CongMark == 0 &&
+		lph.NextHopFaceID == 0 && lph.IncomingFaceID == 0
 }
 
 func (lph LpL3) encode() (fields []tlv.Field) {
@@ -46,6 +57,12 @@ func (lph LpL3) encode() (fields []tlv.Field) {
 	default:
 		fields = append(fields, tlv.TLV(an.TtNack, tlv.TLVNNI(an.TtNackReason, lph.NackReason)))
 	}
+	if lph.IncomingFaceID != 0 {
+		fields = append(fields, tlv.TLVNNI(an.TtIncomingFaceID, lph.IncomingFaceID))
+	}
+	if lph.NextHopFaceID != 0 {
+		fields = append(fields, tlv.TLVNNI(an.TtNextHopFaceID, lph.NextHopFaceID))
+	}
 	if lph.CongMark != 0 {
 		fields = append(fields, tlv.TLVNNI(an.TtCongestionMark, lph.CongMark))
 	}
@@ -147,65 +164,144 @@ func NewLpFragmenter(mtu int) *LpFragmenter {
 	return &fragmenter
 }
 
+// DefaultLpReassemblerTimeout is the default duration a partial packet is kept in LpReassembler.
+const DefaultLpReassemblerTimeout = 500 * time.Millisecond
+
+// lpMaxFragCount is the maximum FragCount accepted by LpReassembler.
+// This prevents a malicious fragment from causing excessive memory allocation.
+const lpMaxFragCount = 1024
+
+// LpReassemblerCounters contains LpReassembler counters.
+type LpReassemblerCounters struct {
+	NDeliverPackets   uint64 // delivered packets
+	NDeliverFragments uint64 // delivered fragments
+	NDropFragments    uint64 // dropped fragments
+}
+
 // LpReassembler reassembles fragments.
+//
+// Partial packets are keyed by the sequence number of the first fragment.
+// A partial packet is discarded if no fragment arrives within the timeout, or if it is the
+// least recently updated one when the capacity is exceeded.
 type LpReassembler struct {
-	pms *cache.Cache[uint64, *lpPartialPacket]
+	// Timeout is the duration a partial packet is kept after its last fragment arrival.
+	// Default is DefaultLpReassemblerTimeout.
+	Timeout time.Duration
+
+	capacity int
+	table    map[uint64]*list.Element
+	list     *list.List // Value is *lpPartialPacket, ordered by last update
+	cnt      LpReassemblerCounters
+}
+
+// Counters returns counters.
+func (reass *LpReassembler) Counters() LpReassemblerCounters {
+	return reass.cnt
 }
 
 // Accept processes a fragment.
 // pkt.Fragment must not be nil.
 func (reass *LpReassembler) Accept(pkt *Packet) (full *Packet, e error) {
-	seq0 := pkt.Fragment.SeqNum - uint64(pkt.Fragment.FragIndex)
+	return reass.AcceptAt(pkt, time.Now())
+}
+
+// AcceptAt processes a fragment that arrives at the specified time.
+// pkt.Fragment must not be nil.
+func (reass *LpReassembler) AcceptAt(pkt *Packet, now time.Time) (full *Packet, e error) {
+	reass.expire(now)
+
+	frag := pkt.Fragment
+	if frag.FragIndex < 0 || frag.FragIndex >= frag.FragCount || frag.FragCount > lpMaxFragCount {
+		reass.cnt.NDropFragments++
+		return nil, ErrFragment
+	}
+	seq0 := frag.SeqNum - uint64(frag.FragIndex)
+
+	elem := reass.table[seq0]
+	if elem == nil {
+		if reass.list.Len() >= reass.capacity {
+			reass.drop(reass.list.Front())
+		}
+		pp := &lpPartialPacket{
+			seq0:   seq0,
+			buffer: make([][]byte, frag.FragCount),
+		}
+		elem = reass.list.PushBack(pp)
+		reass.table[seq0] = elem
+	}
+	pp := elem.Value.(*lpPartialPacket)
+
+	switch {
+	case frag.FragCount != len(pp.buffer): // FragCount changed
+		reass.drop(elem)
+		reass.cnt.NDropFragments++
+		return nil, ErrFragment
+	case pp.buffer[frag.FragIndex] != nil: // duplicate FragIndex
+		reass.cnt.NDropFragments++
+		return nil, ErrFragment
+	}
 
-	pp, _ := reass.pms.Get(seq0)
-	if pp == nil {
-		pp = &lpPartialPacket{}
-		reass.pms.Put(seq0, pp)
+	timeout := reass.Timeout
+	if timeout <= 0 {
+		timeout = DefaultLpReassemblerTimeout
+	}
+	pp.acceptOne(pkt)
+	pp.expire = now.Add(timeout)
+	if pp.accepted < len(pp.buffer) { // waiting for more fragments
+		reass.list.MoveToBack(elem)
+		return nil, nil
 	}
 
-	full, discard, e := pp.Accept(pkt)
-	if discard {
-		reass.pms.Remove(seq0)
+	reass.remove(elem)
+	if full, e = pp.reassemble(); e != nil {
+		reass.cnt.NDropFragments += uint64(pp.accepted)
+		return nil, e
 	}
+	reass.cnt.NDeliverPackets++
+	reass.cnt.NDeliverFragments += uint64(pp.accepted)
+	return full, nil
+}
+
+// expire discards partial packets whose timeout has been reached.
+func (reass *LpReassembler) expire(now time.Time) {
+	for elem := reass.list.Front(); elem != nil; elem = reass.list.Front() {
+		if pp := elem.Value.(*lpPartialPacket); now.Before(pp.expire) {
+			return
+		}
+		reass.drop(elem)
+	}
+}
 
-	return full, e
+// drop discards a partial packet.
+func (reass *LpReassembler) drop(elem *list.Element) {
+	pp := reass.remove(elem)
+	reass.cnt.NDropFragments += uint64(pp.accepted)
+}
+
+func (reass *LpReassembler) remove(elem *list.Element) *lpPartialPacket {
+	pp := reass.list.Remove(elem).(*lpPartialPacket)
+	delete(reass.table, pp.seq0)
+	return pp
 }
 
 // NewLpReassembler creates a LpReassembler.
 func NewLpReassembler(capacity int) *LpReassembler {
 	return &LpReassembler{
-		pms: cache.New[uint64, *lpPartialPacket](capacity),
+		Timeout:  DefaultLpReassemblerTimeout,
+		capacity: generic.Max(1, capacity),
+		table:    map[uint64]*list.Element{},
+		list:     list.New(),
 	}
 }
 
 type lpPartialPacket struct {
+	seq0     uint64
+	expire   time.Time
 	lpl3     LpL3
 	buffer   [][]byte
 	accepted int
 }
 
-func (pp *lpPartialPacket) Accept(pkt *Packet) (full *Packet, discard bool, e error) {
-	if pp.accepted == 0 {
-		pp.buffer = make([][]byte, pkt.Fragment.FragCount)
-		pp.acceptOne(pkt)
-		return nil, false, nil
-	}
-
-	if pkt.Fragment.FragCount != len(pp.buffer) {
-		return nil, true, ErrFragment
-	}
-	if pp.buffer[pkt.Fragment.FragIndex] != nil {
-		return nil, false, ErrFragment
-	}
-
-	pp.acceptOne(pkt)
-	if pp.accepted == len(pp.buffer) {
-		full, e = pp.reassemble()
-		return full, true, e
-	}
-	return nil, false, nil
-}
-
 func (pp *lpPartialPacket) acceptOne(pkt *Packet) {
 	if pkt.Fragment.FragIndex == 0 {
 		pp.lpl3 = pkt.Lp
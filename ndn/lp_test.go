@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
@@ -74,3 +75,114 @@ func TestLpReassembler(t *testing.T) {
 	}
 	assert.Equal(0, packetSet.Size())
 }
+
+func TestLpFaceID(t *testing.T) {
+	assert, require := makeAR(t)
+
+	var lph ndn.LpL3
+	lph.NextHopFaceID = 500
+	lph.IncomingFaceID = 3
+	assert.False(lph.Empty())
+	assert.Zero(ndn.MakeInterest("/A", lph).ToPacket().Lp.NextHopFaceID)
+
+	packet := ndn.MakeInterest("/A", ndn.NonceFromUint(0xC0C1C2C3)).ToPacket()
+	packet.Lp = lph
+	wire, e := tlv.EncodeFrom(packet)
+	require.NoError(e)
+	assert.Contains(string(wire),
+		string(bytesFromHex("incoming=FD032C0103 nexthop=FD03300201F4")))
+
+	var pkt ndn.Packet
+	require.NoError(tlv.Decode(wire, &pkt))
+	assert.EqualValues(500, pkt.Lp.NextHopFaceID)
+	assert.EqualValues(3, pkt.Lp.IncomingFaceID)
+}
+
+func TestLpReassemblerDrop(t *testing.T) {
+	assert, require := makeAR(t)
+
+	makeFrags := func(fragmenter *ndn.LpFragmenter, name string) (frags []*ndn.Packet) {
+		data := ndn.MakeData(name, bytes.Repeat([]byte{0xCC}, 2500))
+		frags, e := fragmenter.Fragment(data.ToPacket())
+		require.NoError(e)
+		require.Len(frags, 3)
+		for i, frag := range frags { // roundtrip to simulate received fragments
+			wire, e := tlv.EncodeFrom(frag)
+			require.NoError(e)
+			frags[i] = &ndn.Packet{}
+			require.NoError(tlv.Decode(wire, frags[i]))
+		}
+		return frags
+	}
+	fragmenter := ndn.NewLpFragmenter(1000)
+	reassembler := ndn.NewLpReassembler(2)
+	reassembler.Timeout = 100 * time.Millisecond
+	t0 := time.Unix(1600000000, 0)
+
+	// timeout since last fragment
+	fragsA := makeFrags(fragmenter, "/A")
+	pkt, e := reassembler.AcceptAt(fragsA[0], t0)
+	assert.NoError(e)
+	assert.Nil(pkt)
+	pkt, e = reassembler.AcceptAt(fragsA[2], t0.Add(80*time.Millisecond))
+	assert.NoError(e)
+	assert.Nil(pkt)
+	pkt, e = reassembler.AcceptAt(fragsA[1], t0.Add(200*time.Millisecond))
+	assert.NoError(e)
+	assert.Nil(pkt)
+	cnt := reassembler.Counters()
+	assert.EqualValues(0, cnt.NDeliverPackets)
+	assert.EqualValues(2, cnt.NDropFragments)
+
+	// duplicate FragIndex
+	fragsB := makeFrags(fragmenter, "/B")
+	t1 := t0.Add(time.Second)
+	_, e = reassembler.AcceptAt(fragsB[1], t1)
+	assert.NoError(e)
+	_, e = reassembler.AcceptAt(fragsB[1], t1)
+	assert.ErrorIs(e, ndn.ErrFragment)
+	_, e = reassembler.AcceptAt(fragsB[0], t1)
+	assert.NoError(e)
+	pkt, e = reassembler.AcceptAt(fragsB[2], t1)
+	assert.NoError(e)
+	if assert.NotNil(pkt) && assert.NotNil(pkt.Data) {
+		nameEqual(assert, "/B", pkt.Data)
+	}
+	cnt = reassembler.Counters()
+	assert.EqualValues(1, cnt.NDeliverPackets)
+	assert.EqualValues(3, cnt.NDeliverFragments)
+	assert.EqualValues(3+1, cnt.NDropFragments)
+
+	// FragCount changed
+	fragsC := makeFrags(fragmenter, "/C")
+	_, e = reassembler.AcceptAt(fragsC[0], t1)
+	assert.NoError(e)
+	fragsC[1].Fragment.FragCount++
+	_, e = reassembler.AcceptAt(fragsC[1], t1)
+	assert.ErrorIs(e, ndn.ErrFragment)
+	cnt = reassembler.Counters()
+	assert.EqualValues(4+2, cnt.NDropFragments)
+
+	// capacity exceeded, least recently updated partial packet is evicted
+	fragsD, fragsE, fragsF := makeFrags(fragmenter, "/D"), makeFrags(fragmenter, "/E"), makeFrags(fragmenter, "/F")
+	for _, frag := range []*ndn.Packet{fragsD[0], fragsE[0], fragsD[1], fragsF[0]} {
+		_, e = reassembler.AcceptAt(frag, t1)
+		assert.NoError(e)
+	}
+	pkt, e = reassembler.AcceptAt(fragsD[2], t1)
+	assert.NoError(e)
+	assert.NotNil(pkt)
+	_, e = reassembler.AcceptAt(fragsE[1], t1)
+	assert.NoError(e)
+	pkt, e = reassembler.AcceptAt(fragsE[2], t1)
+	assert.NoError(e)
+	assert.Nil(pkt)
+	cnt = reassembler.Counters()
+	assert.EqualValues(2, cnt.NDeliverPackets)
+	assert.EqualValues(6+1, cnt.NDropFragments)
+
+	// excessive FragCount
+	huge := &ndn.Packet{Fragment: &ndn.LpFragment{SeqNum: 1, FragIndex: 0, FragCount: 1 << 20, Payload: []byte{0xC0}}}
+	_, e = reassembler.AcceptAt(huge, t1)
+	assert.ErrorIs(e, ndn.ErrFragment)
+}
@@ -10,8 +10,10 @@ import (
 	stdlog "log"
 	"os"
 	"os/exec"
+	"sync"
 	"time"
 
+	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/l3"
 	"github.com/zyedidia/generic"
 )
@@ -78,16 +80,109 @@ func NewBridge(locA, locB Locator) (bridge *Bridge, e error) {
 	return bridge, nil
 }
 
-const bridgeArg = "986a6a90-4c54-44a0-a585-edee6104d4fa"
+// L3Bridge bridges two memif interfaces at network layer.
+// Each memif is attached to an l3.Face, so that NDNLPv2 fragments received on one memif are
+// reassembled, and then fragmented again according to the MTU of the other memif.
+//
+// This is mainly useful for testing interoperability between NDNgo and NDN-DPDK fragmentation.
+// The test program should run this bridge in a separate process, same as Bridge.
+type L3Bridge struct {
+	faceA     l3.Face
+	faceB     l3.Face
+	closing   chan struct{}
+	relays    sync.WaitGroup
+	closeOnce sync.Once
+}
+
+// relay forwards packets from src to dst.
+// It does not block Close, so that a stalled direction cannot hold up the other.
+func (bridge *L3Bridge) relay(src, dst l3.Face) {
+	defer bridge.relays.Done()
+	for {
+		select {
+		case <-bridge.closing:
+			return
+		case pkt, ok := <-src.Rx():
+			if !ok {
+				return
+			}
+			select {
+			case <-bridge.closing:
+				return
+			case dst.Tx() <- pkt:
+			}
+		}
+	}
+}
+
+// ReassemblerCounters returns reassembler counters of the l3.Face on each memif.
+func (bridge *L3Bridge) ReassemblerCounters() (cntA, cntB ndn.LpReassemblerCounters) {
+	return bridge.faceA.ReassemblerCounters(), bridge.faceB.ReassemblerCounters()
+}
+
+// Close stops the bridge.
+func (bridge *L3Bridge) Close() error {
+	bridge.closeOnce.Do(func() {
+		close(bridge.closing)
+		bridge.relays.Wait()
+		close(bridge.faceA.Tx())
+		close(bridge.faceB.Tx())
+	})
+	return nil
+}
+
+// NewL3Bridge creates an L3Bridge.
+func NewL3Bridge(locA, locB Locator) (bridge *L3Bridge, e error) {
+	trA, e := New(locA)
+	if e != nil {
+		return nil, fmt.Errorf("New(locA) %w", e)
+	}
+	trB, e := New(locB)
+	if e != nil {
+		trA.Close()
+		return nil, fmt.Errorf("New(locB) %w", e)
+	}
+
+	bridge = &L3Bridge{closing: make(chan struct{})}
+	if bridge.faceA, e = l3.NewFace(trA, l3.FaceConfig{}); e != nil {
+		trA.Close()
+		trB.Close()
+		return nil, fmt.Errorf("l3.NewFace(trA) %w", e)
+	}
+	if bridge.faceB, e = l3.NewFace(trB, l3.FaceConfig{}); e != nil {
+		close(bridge.faceA.Tx())
+		trB.Close()
+		return nil, fmt.Errorf("l3.NewFace(trB) %w", e)
+	}
+
+	bridge.relays.Add(2)
+	go bridge.relay(bridge.faceA, bridge.faceB)
+	go bridge.relay(bridge.faceB, bridge.faceA)
+	return bridge, nil
+}
+
+const (
+	bridgeArg   = "986a6a90-4c54-44a0-a585-edee6104d4fa"
+	l3BridgeArg = "3b0e3c6d-9c3e-4f0e-8f5e-6ad1c1f4b2a7"
+)
 
 // ForkBridgeHelper forks a bridge helper subprocess and invokes f() while it's running.
 func ForkBridgeHelper(locA, locB Locator, f func()) error {
+	return forkBridgeHelper(bridgeArg, locA, locB, f)
+}
+
+// ForkL3BridgeHelper forks an L3Bridge helper subprocess and invokes f() while it's running.
+func ForkL3BridgeHelper(locA, locB Locator, f func()) error {
+	return forkBridgeHelper(l3BridgeArg, locA, locB, f)
+}
+
+func forkBridgeHelper(arg string, locA, locB Locator, f func()) error {
 	time.Sleep(1 * time.Second)
 
 	locAj, _ := json.Marshal(locA)
 	locBj, _ := json.Marshal(locB)
 
-	helper := exec.Command(os.Args[0], bridgeArg, string(locAj), string(locBj))
+	helper := exec.Command(os.Args[0], arg, string(locAj), string(locBj))
 	helperIn, e := helper.StdinPipe()
 	if e != nil {
 		return fmt.Errorf("helper.StdinPipe() %w", e)
@@ -112,13 +207,13 @@ func ForkBridgeHelper(locA, locB Locator, f func()) error {
 // ExecBridgeHelper runs the bridge helper if os.Args requests it.
 // In unit test binary, this should be invoked in TestMain() function.
 func ExecBridgeHelper() {
-	if len(os.Args) == 4 && os.Args[1] == bridgeArg {
-		res := runBridgeHelper()
+	if len(os.Args) == 4 && (os.Args[1] == bridgeArg || os.Args[1] == l3BridgeArg) {
+		res := runBridgeHelper(os.Args[1] == l3BridgeArg)
 		os.Exit(res)
 	}
 }
 
-func runBridgeHelper() int {
+func runBridgeHelper(isL3 bool) int {
 	stdlog.SetFlags(0)
 	stdlog.SetPrefix("memifBridgeHelper ")
 
@@ -132,7 +227,13 @@ func runBridgeHelper() int {
 		return 1
 	}
 
-	bridge, e := NewBridge(locA, locB)
+	var bridge io.Closer
+	var e error
+	if isL3 {
+		bridge, e = NewL3Bridge(locA, locB)
+	} else {
+		bridge, e = NewBridge(locA, locB)
+	}
 	if e != nil {
 		stdlog.Print("NewBridge", e)
 		return 2
@@ -140,6 +241,10 @@ func runBridgeHelper() int {
 	stdlog.Print("Bridge open")
 
 	io.ReadAtLeast(os.Stdin, make([]byte, 1), 1)
+	if l3b, ok := bridge.(*L3Bridge); ok {
+		cntA, cntB := l3b.ReassemblerCounters()
+		stdlog.Printf("Reassembler counters A=%+v B=%+v", cntA, cntB)
+	}
 	if e := bridge.Close(); e != nil {
 		stdlog.Print("bridge.Close()", e)
 		return 3
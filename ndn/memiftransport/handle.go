@@ -3,6 +3,7 @@
 package memiftransport
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -20,14 +21,21 @@ func (loc *Locator) toArguments() (a *memif.Arguments, e error) {
 	if e := loc.Validate(); e != nil {
 		return nil, e
 	}
-	loc.ApplyDefaults(RoleClient)
+	loc.ApplyDefaults(loc.NDNgoDefaultRole())
+	if loc.NQueues > 1 && loc.Role == RoleClient {
+		// gomemif library in client role initializes descriptors incorrectly in queues other than the first
+		return nil, errors.New("multiple queue pairs are only supported in server role")
+	}
+	if loc.ZeroCopy && loc.Role == RoleClient {
+		return nil, errors.New("ZeroCopy requires server role in NDNgo")
+	}
 
 	return &memif.Arguments{
 		Id:       uint32(loc.ID),
 		IsMaster: loc.Role == RoleServer,
 		Name:     os.Args[0],
 		MemoryConfig: memif.MemoryConfig{
-			NumQueuePairs:    1,
+			NumQueuePairs:    uint16(loc.NQueues),
 			Log2RingSize:     loc.rsize(),
 			PacketBufferSize: uint32(loc.Dataroom),
 		},
@@ -83,8 +91,9 @@ type handle struct {
 	setState   func(l3.TransportState)
 
 	mutex         sync.RWMutex
-	rxq           *memif.Queue
-	txq           *memif.Queue
+	rxqs          []*memif.Queue
+	txqs          []*memif.Queue
+	rxNext        int
 	lastReadEmpty bool
 	closed        bool
 }
@@ -106,7 +115,7 @@ func (hdl *handle) reconnect() error {
 	if hdl.closed {
 		return io.ErrClosedPipe
 	}
-	if hdl.rxq != nil {
+	if len(hdl.rxqs) > 0 {
 		return nil
 	}
 	return hdl.intf.RequestConnection()
@@ -115,8 +124,19 @@ func (hdl *handle) reconnect() error {
 func (hdl *handle) memifConnected(intf *memif.Interface) error {
 	hdl.mutex.Lock()
 	defer hdl.mutex.Unlock()
-	hdl.rxq, _ = intf.GetRxQueue(0)
-	hdl.txq, _ = intf.GetTxQueue(0)
+	hdl.rxqs, hdl.txqs, hdl.rxNext = nil, nil, 0
+	for qid := 0; ; qid++ {
+		rxq, e := intf.GetRxQueue(qid)
+		if e != nil {
+			break
+		}
+		txq, e := intf.GetTxQueue(qid)
+		if e != nil {
+			break
+		}
+		hdl.rxqs = append(hdl.rxqs, rxq)
+		hdl.txqs = append(hdl.txqs, txq)
+	}
 	hdl.setState(l3.TransportUp)
 	return nil
 }
@@ -124,8 +144,7 @@ func (hdl *handle) memifConnected(intf *memif.Interface) error {
 func (hdl *handle) memifDisconnected(intf *memif.Interface) error {
 	hdl.mutex.Lock()
 	defer hdl.mutex.Unlock()
-	hdl.rxq = nil
-	hdl.txq = nil
+	hdl.rxqs, hdl.txqs = nil, nil
 	hdl.setState(l3.TransportDown)
 	return nil
 }
@@ -138,19 +157,33 @@ func (hdl *handle) Read(buf []byte) (n int, e error) {
 		return 0, io.EOF
 	}
 
-	if hdl.rxq != nil {
-		if hdl.lastReadEmpty {
-			if fd, e := hdl.rxq.GetEventFd(); e == nil {
-				unix.Poll([]unix.PollFd{{
+	nQueues := len(hdl.rxqs)
+	if nQueues == 0 {
+		return 0, nil
+	}
+
+	if hdl.lastReadEmpty {
+		fds := make([]unix.PollFd, 0, nQueues)
+		for _, rxq := range hdl.rxqs {
+			if fd, e := rxq.GetEventFd(); e == nil {
+				fds = append(fds, unix.PollFd{
 					Fd:     int32(fd),
 					Events: unix.POLLIN,
-				}}, 1)
+				})
 			}
 		}
+		unix.Poll(fds, 1)
+	}
 
-		n, e = hdl.rxq.ReadPacket(buf)
-		hdl.lastReadEmpty = n == 0
+	// round robin among queues, starting after the queue that last returned a packet
+	for i := 0; i < nQueues; i++ {
+		qid := (hdl.rxNext + i) % nQueues
+		if n, e = hdl.rxqs[qid].ReadPacket(buf); n > 0 || e != nil {
+			hdl.rxNext = (qid + 1) % nQueues
+			break
+		}
 	}
+	hdl.lastReadEmpty = n == 0
 	return n, e
 }
 
@@ -158,8 +191,8 @@ func (hdl *handle) Write(buf []byte) (n int, e error) {
 	hdl.mutex.RLock()
 	defer hdl.mutex.RUnlock()
 
-	if hdl.txq != nil {
-		n = hdl.txq.WritePacket(buf)
+	if nQueues := len(hdl.txqs); nQueues > 0 {
+		n = hdl.txqs[TxQueueIndex(buf, nQueues)].WritePacket(buf)
 	}
 
 	if n < len(buf) {
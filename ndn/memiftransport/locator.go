@@ -25,6 +25,8 @@ const (
 	MinRingCapacity     = 1 << 1
 	MaxRingCapacity     = 1 << 14
 	DefaultRingCapacity = 1 << 10
+
+	MaxQueues = 8
 )
 
 // Role indicates memif role.
@@ -39,7 +41,8 @@ const (
 // Locator identifies memif interface.
 type Locator struct {
 	// Role selects memif role.
-	// Default is "client" in NDNgo library and "server" in NDN-DPDK service.
+	// Default is "server" in NDN-DPDK service.
+	// Default in NDNgo library is "client", or "server" if NQueues or ZeroCopy requires it, see NDNgoDefaultRole.
 	Role Role `json:"role,omitempty"`
 
 	// SocketName is the control socket filename.
@@ -63,6 +66,22 @@ type Locator struct {
 	// Default is DefaultRingCapacity.
 	// It is automatically adjusted up to the next power of 2, and clamped between MinRingCapacity and MaxRingCapacity.
 	RingCapacity int `json:"ringCapacity,omitempty"`
+
+	// NQueues is the number of queue pairs.
+	// Default is 1.
+	// It is automatically clamped between 1 and MaxQueues.
+	//
+	// The number of queue pairs in use is the lesser of what's requested by each peer.
+	// NDN-DPDK service receives from each queue in a separate RxGroup.
+	// NDNgo library transmits each frame in a queue selected by TxQueueIndex;
+	// it supports multiple queue pairs only in "server" role, so that NDN-DPDK should use "client" role.
+	NQueues int `json:"nQueues,omitempty"`
+
+	// ZeroCopy enables zero-copy mode in NDN-DPDK service.
+	// In this mode, NDN-DPDK exposes its packet buffers as memif shared memory, so that packets are
+	// not copied between memif buffers and mbufs.
+	// It requires "client" role in NDN-DPDK service, i.e. "server" role in NDNgo library.
+	ZeroCopy bool `json:"zeroCopy,omitempty"`
 }
 
 // Validate checks Locator fields.
@@ -88,6 +107,16 @@ func (loc Locator) Validate() error {
 	return nil
 }
 
+// NDNgoDefaultRole returns the default Role in NDNgo library.
+// It is "server" if multiple queue pairs or zero-copy mode are requested, because NDNgo supports these
+// features only in "server" role; otherwise, it is "client".
+func (loc Locator) NDNgoDefaultRole() Role {
+	if loc.NQueues > 1 || loc.ZeroCopy {
+		return RoleServer
+	}
+	return RoleClient
+}
+
 // ApplyDefaults sets empty values to defaults.
 func (loc *Locator) ApplyDefaults(defaultRole Role) {
 	loc.SocketName = path.Clean(loc.SocketName)
@@ -108,6 +137,8 @@ func (loc *Locator) ApplyDefaults(defaultRole Role) {
 		loc.RingCapacity = generic.Clamp(loc.RingCapacity, MinRingCapacity, MaxRingCapacity)
 	}
 	loc.RingCapacity = int(binutils.NextPowerOfTwo(int64(loc.RingCapacity)))
+
+	loc.NQueues = generic.Clamp(loc.NQueues, 1, MaxQueues)
 }
 
 // ReverseRole returns a copy of Locator with server and client roles reversed.
@@ -135,6 +166,9 @@ func (loc *Locator) ToVDevArgs() (args map[string]any, e error) {
 		return nil, e
 	}
 	loc.ApplyDefaults(RoleServer)
+	if loc.ZeroCopy && loc.Role != RoleClient {
+		return nil, errors.New("ZeroCopy requires client role in NDN-DPDK")
+	}
 
 	args = map[string]any{
 		"id":              loc.ID,
@@ -149,6 +183,9 @@ func (loc *Locator) ToVDevArgs() (args map[string]any, e error) {
 		args["owner-uid"] = owner[0]
 		args["owner-gid"] = owner[1]
 	}
+	if loc.ZeroCopy {
+		args["zero-copy"] = "yes"
+	}
 	return args, nil
 }
 
@@ -0,0 +1,81 @@
+package memiftransport
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// TxQueueIndex selects a TX queue for an outgoing frame.
+//
+// An unfragmented Interest or Data is placed by hash of its name, so that packets of the same name
+// stay in the same queue.
+// An NDNLPv2 fragment is placed by hash of its base sequence number, so that all fragments of a
+// network layer packet arrive at the same queue, as required by reassembly on the receiver side.
+// Frames that cannot be recognized are placed in queue 0.
+func TxQueueIndex(wire []byte, nQueues int) int {
+	if nQueues <= 1 {
+		return 0
+	}
+	return int(frameHash(wire) % uint64(nQueues))
+}
+
+func frameHash(wire []byte) uint64 {
+	d := tlv.DecodingBuffer(wire)
+	de, e := d.Element()
+	if e != nil {
+		return 0
+	}
+
+	switch de.Type {
+	case an.TtInterest, an.TtData:
+		return nameHash(de.Value)
+	case an.TtLpPacket:
+	default:
+		return 0
+	}
+
+	var seqNum, fragIndex, fragCount uint64
+	var nniErr error
+	d = tlv.DecodingBuffer(de.Value)
+	for _, field := range d.Elements() {
+		switch field.Type {
+		case an.TtLpSeqNum:
+			if len(field.Value) == 8 {
+				seqNum = binary.BigEndian.Uint64(field.Value)
+			}
+		case an.TtFragIndex:
+			fragIndex = field.UnmarshalNNI(math.MaxUint64, &nniErr, nil)
+		case an.TtFragCount:
+			fragCount = field.UnmarshalNNI(math.MaxUint64, &nniErr, nil)
+		case an.TtLpPayload:
+			if fragCount > 1 {
+				return seqHash(seqNum - fragIndex)
+			}
+			return frameHash(field.Value)
+		}
+	}
+	return 0
+}
+
+func nameHash(l3value []byte) uint64 {
+	d := tlv.DecodingBuffer(l3value)
+	de, e := d.Element()
+	if e != nil || de.Type != an.TtName {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(de.Value)
+	return h.Sum64()
+}
+
+func seqHash(seqNumBase uint64) uint64 {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], seqNumBase)
+	h := fnv.New64a()
+	h.Write(b[:])
+	return h.Sum64()
+}
@@ -0,0 +1,45 @@
+package memiftransport_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/memiftransport"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+func TestTxQueueIndex(t *testing.T) {
+	assert, require := makeAR(t)
+
+	encode := func(pkt *ndn.Packet) []byte {
+		wire, e := tlv.EncodeFrom(pkt)
+		require.NoError(e)
+		return wire
+	}
+
+	interest := encode(ndn.MakeInterest("/A/1").ToPacket())
+	assert.Equal(0, memiftransport.TxQueueIndex(interest, 1))
+	assert.Equal(memiftransport.TxQueueIndex(interest, 4), memiftransport.TxQueueIndex(interest, 4))
+
+	used := map[int]bool{}
+	for i := 0; i < 64; i++ {
+		wire := encode(ndn.MakeData(fmt.Sprintf("/D/%d", i)).ToPacket())
+		q := memiftransport.TxQueueIndex(wire, 4)
+		assert.GreaterOrEqual(q, 0)
+		assert.Less(q, 4)
+		used[q] = true
+	}
+	assert.Len(used, 4)
+
+	fragmenter := ndn.NewLpFragmenter(800)
+	frags, e := fragmenter.Fragment(ndn.MakeData("/F", make([]byte, 3000)).ToPacket())
+	require.NoError(e)
+	require.Greater(len(frags), 1)
+	q0 := memiftransport.TxQueueIndex(encode(frags[0]), 4)
+	for _, frag := range frags[1:] {
+		assert.Equal(q0, memiftransport.TxQueueIndex(encode(frag), 4))
+	}
+
+	assert.Equal(0, memiftransport.TxQueueIndex([]byte{0xFF}, 4))
+}
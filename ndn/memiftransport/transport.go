@@ -10,6 +10,8 @@ import (
 )
 
 // Transport is an l3.Transport that communicates via libmemif.
+// Each packet is copied once between memif shared memory and a Go buffer, because gomemif does not
+// expose its packet buffers; Locator.ZeroCopy eliminates the copy on NDN-DPDK side.
 type Transport interface {
 	l3.Transport
 
@@ -21,7 +23,7 @@ func New(loc Locator) (t Transport, e error) {
 	if e := loc.Validate(); e != nil {
 		return nil, fmt.Errorf("loc.Validate %w", e)
 	}
-	loc.ApplyDefaults(RoleClient)
+	loc.ApplyDefaults(loc.NDNgoDefaultRole())
 
 	tr := &transport{}
 	tr.TransportBase, tr.p = l3.NewTransportBase(l3.TransportBaseConfig{
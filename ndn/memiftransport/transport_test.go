@@ -37,3 +37,50 @@ func TestTransport(t *testing.T) {
 		c.CheckTransport(t, trA, trB)
 	}))
 }
+
+func TestTransportMultiQueueClient(t *testing.T) {
+	assert, _ := makeAR(t)
+	dir := t.TempDir()
+
+	_, e := memiftransport.New(memiftransport.Locator{
+		Role:       memiftransport.RoleClient,
+		SocketName: path.Join(dir, "memif.sock"),
+		ID:         1216,
+		NQueues:    2,
+	})
+	assert.Error(e)
+
+	_, e = memiftransport.New(memiftransport.Locator{
+		Role:       memiftransport.RoleClient,
+		SocketName: path.Join(dir, "memif.sock"),
+		ID:         1217,
+		ZeroCopy:   true,
+	})
+	assert.Error(e)
+
+	assert.Equal(memiftransport.RoleClient, memiftransport.Locator{}.NDNgoDefaultRole())
+	assert.Equal(memiftransport.RoleServer, memiftransport.Locator{NQueues: 2}.NDNgoDefaultRole())
+	assert.Equal(memiftransport.RoleServer, memiftransport.Locator{ZeroCopy: true}.NDNgoDefaultRole())
+}
+
+func TestZeroCopyVDevArgs(t *testing.T) {
+	assert, require := makeAR(t)
+
+	loc := memiftransport.Locator{
+		SocketName: "/run/ndn/memif.sock",
+		ID:         1218,
+		ZeroCopy:   true,
+	}
+	_, e := loc.ToVDevArgs()
+	assert.Error(e)
+
+	loc.Role = memiftransport.RoleClient
+	args, e := loc.ToVDevArgs()
+	require.NoError(e)
+	assert.Equal("yes", args["zero-copy"])
+
+	loc.ZeroCopy = false
+	args, e = loc.ToVDevArgs()
+	require.NoError(e)
+	assert.NotContains(args, "zero-copy")
+}
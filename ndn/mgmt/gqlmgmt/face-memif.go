@@ -45,15 +45,27 @@ func (c *Client) OpenMemif(loc memiftransport.Locator) (mgmt.Face, error) {
 		loc.ID = int(autoMemifID.Add(1))
 		loc.SocketOwner = &[2]int{os.Getuid(), os.Getgid()}
 	}
-	loc.ApplyDefaults(memiftransport.RoleClient)
+	loc.ApplyDefaults(loc.NDNgoDefaultRole())
 
 	locR := loc.ReverseRole()
 	locJ, e := locR.ToCreateFaceLocator()
 	if e != nil {
 		return nil, fmt.Errorf("loc.ToCreateFaceLocator: %w", e)
 	}
+
+	// in server role, the socket must exist before NDN-DPDK connects as client
+	var tr memiftransport.Transport
+	if loc.Role == memiftransport.RoleServer {
+		if tr, e = memiftransport.New(loc); e != nil {
+			return nil, fmt.Errorf("memiftransport.New: %w", e)
+		}
+	}
+
 	id, e := c.CreateFace(context.TODO(), locJ)
 	if e != nil {
+		if tr != nil {
+			tr.Close()
+		}
 		return nil, e
 	}
 
@@ -62,14 +74,15 @@ func (c *Client) OpenMemif(loc memiftransport.Locator) (mgmt.Face, error) {
 		id:     id,
 		routes: map[string]string{},
 	}
-	return f, f.openMemif(loc)
+	return f, f.openMemif(loc, tr)
 }
 
-func (f *face) openMemif(loc memiftransport.Locator) error {
-	tr, e := memiftransport.New(loc)
-	if e != nil {
-		must.Close(f)
-		return fmt.Errorf("memiftransport.New: %w", e)
+func (f *face) openMemif(loc memiftransport.Locator, tr memiftransport.Transport) (e error) {
+	if tr == nil {
+		if tr, e = memiftransport.New(loc); e != nil {
+			must.Close(f)
+			return fmt.Errorf("memiftransport.New: %w", e)
+		}
 	}
 
 	tr.OnStateChange(func(st l3.TransportState) {
@@ -18,10 +18,11 @@ const (
 
 // RouteOrigin assigned numbers.
 const (
-	RouteOriginApp     = 0
-	RouteOriginStatic  = 255
-	RouteOriginClient  = 65
-	RouteOriginAutoReg = 64
+	RouteOriginApp       = 0
+	RouteOriginStatic    = 255
+	RouteOriginClient    = 65
+	RouteOriginAutoReg   = 64
+	RouteOriginPrefixAnn = 129
 )
 
 // Command prefixes.
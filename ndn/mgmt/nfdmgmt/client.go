@@ -4,6 +4,7 @@ package nfdmgmt
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
@@ -16,6 +17,16 @@ type Client struct {
 	ConsumerOpts endpoint.ConsumerOptions
 	Prefix       ndn.Name
 	Signer       ndn.Signer
+
+	// PrefixAnnSigner, if set, enables prefix registration via signed prefix announcements.
+	// In this case, the face returned by OpenFace sends rib/announce commands with prefix announcements signed by this signer,
+	// and withdraws the routes with RouteOriginPrefixAnn.
+	PrefixAnnSigner ndn.Signer
+
+	// PrefixAnnExpiration is the ExpirationPeriod of prefix announcements sent by the face returned by OpenFace.
+	// Default is 1 hour.
+	// The face re-announces each advertised prefix at half of this period, until it is withdrawn or the face is closed.
+	PrefixAnnExpiration time.Duration
 }
 
 var _ mgmt.Client = (*Client)(nil)
@@ -43,7 +54,10 @@ func (c *Client) Close() error {
 
 // Invoke invokes a control command.
 func (c *Client) Invoke(ctx context.Context, cmd ControlCommand) (cr ControlResponse, e error) {
-	interest := MakeCommandInterest(c.Prefix, cmd)
+	interest, e := BuildCommandInterest(c.Prefix, cmd)
+	if e != nil {
+		return cr, e
+	}
 	if e = c.Signer.Sign(&interest); e != nil {
 		return cr, fmt.Errorf("signing error: %w", e)
 	}
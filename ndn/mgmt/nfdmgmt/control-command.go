@@ -16,20 +16,49 @@ type ControlCommand interface {
 	Parameters() []tlv.Fielder
 }
 
+// AppParametersCommand is a NFD control command that carries its parameters in ApplicationParameters,
+// instead of ControlParameters name component.
+type AppParametersCommand interface {
+	AppParameters() ([]byte, error)
+}
+
 // MakeCommandInterest constructs a control command Interest without signing.
+// The command is encoded as a ControlParameters name component; ApplicationParameters are not included.
+// AppParametersCommand such as RibAnnounceCommand must be constructed with BuildCommandInterest.
 func MakeCommandInterest(commandPrefix ndn.Name, cmd ControlCommand) ndn.Interest {
-	name := commandPrefix.Append(cmd.Verb()...)
-	name = append(name, ndn.NameComponentFrom(an.TtGenericNameComponent, tlv.TLVFrom(TtControlParameters, cmd.Parameters()...)))
+	name := append(commandPrefix.Append(cmd.Verb()...),
+		ndn.NameComponentFrom(an.TtGenericNameComponent, tlv.TLVFrom(TtControlParameters, cmd.Parameters()...)))
+	return makeCommandInterest(name, nil)
+}
+
+// BuildCommandInterest constructs a control command Interest without signing.
+// If cmd is an AppParametersCommand, the command is encoded as ApplicationParameters,
+// and an error is returned if they cannot be encoded.
+func BuildCommandInterest(commandPrefix ndn.Name, cmd ControlCommand) (ndn.Interest, error) {
+	apc, ok := cmd.(AppParametersCommand)
+	if !ok {
+		return MakeCommandInterest(commandPrefix, cmd), nil
+	}
+
+	appParams, e := apc.AppParameters()
+	if e != nil {
+		return ndn.Interest{}, e
+	}
+	return makeCommandInterest(commandPrefix.Append(cmd.Verb()...), appParams), nil
+}
+
+func makeCommandInterest(name ndn.Name, appParams []byte) (interest ndn.Interest) {
+	interest.Name = name
+	interest.AppParameters = appParams
+
 	var sigNonce [8]byte
 	rand.Read(sigNonce[:])
-	return ndn.Interest{
-		Name:        name,
-		MustBeFresh: true,
-		SigInfo: &ndn.SigInfo{
-			Nonce: sigNonce[:],
-			Time:  uint64(time.Now().UnixMilli()),
-		},
+	interest.MustBeFresh = true
+	interest.SigInfo = &ndn.SigInfo{
+		Nonce: sigNonce[:],
+		Time:  uint64(time.Now().UnixMilli()),
 	}
+	return interest
 }
 
 // ControlResponse represents a NFD control response.
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/l3"
@@ -14,6 +16,20 @@ import (
 type nfdFace struct {
 	client *Client
 	l3face l3.Face
+
+	mutex   sync.Mutex
+	closed  bool
+	refresh map[string]*paRefresh
+
+	// cmdMutex serializes rib/announce and rib/unregister commands, so that an in-flight
+	// refresh cannot re-register a prefix after it has been withdrawn.
+	cmdMutex sync.Mutex
+}
+
+// paRefresh is a pending re-announcement of a prefix announcement.
+type paRefresh struct {
+	timer    *time.Timer
+	interval time.Duration // refresh interval after a successful announcement
 }
 
 func (f *nfdFace) ID() string {
@@ -25,30 +41,112 @@ func (f *nfdFace) Face() l3.Face {
 }
 
 func (f *nfdFace) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.closed = true
+	for key, r := range f.refresh {
+		r.timer.Stop()
+		delete(f.refresh, key)
+	}
 	return nil
 }
 
 func (f *nfdFace) Advertise(name ndn.Name) error {
-	cr, e := f.client.Invoke(context.TODO(), RibRegisterCommand{
-		Name:      name,
-		Origin:    RouteOriginClient,
-		NoInherit: true,
-		Capture:   true,
-	})
+	if f.client.PrefixAnnSigner == nil {
+		return f.invoke(RibRegisterCommand{
+			Name:      name,
+			Origin:    RouteOriginClient,
+			NoInherit: true,
+			Capture:   true,
+		})
+	}
+
+	f.cmdMutex.Lock()
+	defer f.cmdMutex.Unlock()
+	interval, e := f.announce(name)
 	if e != nil {
 		return e
 	}
-	if cr.StatusCode != 200 {
-		return fmt.Errorf("unexpected response status %d", cr.StatusCode)
-	}
+	f.scheduleRefresh(name, nil, interval, interval)
 	return nil
 }
 
+// announce sends a rib/announce command with a new prefix announcement.
+// Returns the interval until the announcement should be refreshed.
+func (f *nfdFace) announce(name ndn.Name) (interval time.Duration, e error) {
+	pa, e := MakePrefixAnn(f.client.PrefixAnnSigner, MakePrefixAnnOptions{
+		Prefix:     name,
+		Expiration: f.client.PrefixAnnExpiration,
+	})
+	if e != nil {
+		return 0, e
+	}
+	return pa.Expiration() / 2, f.invoke(RibAnnounceCommand{PrefixAnn: *pa})
+}
+
+// scheduleRefresh arranges for a prefix announcement to be refreshed after a delay.
+// interval is the regular refresh interval, to be recorded for retries.
+// If prev is not nil, the schedule is only updated if prev is still the pending refresh of this name,
+// i.e. the name has not been withdrawn or re-advertised in the meantime.
+func (f *nfdFace) scheduleRefresh(name ndn.Name, prev *paRefresh, interval, after time.Duration) {
+	key := name.String()
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	cur := f.refresh[key]
+	if f.closed || (prev != nil && cur != prev) {
+		return
+	}
+	if cur != nil {
+		cur.timer.Stop()
+	}
+
+	r := &paRefresh{interval: interval}
+	r.timer = time.AfterFunc(after, func() { f.doRefresh(name, r) })
+	f.refresh[key] = r
+}
+
+func (f *nfdFace) doRefresh(name ndn.Name, r *paRefresh) {
+	f.cmdMutex.Lock()
+	defer f.cmdMutex.Unlock()
+
+	f.mutex.Lock()
+	current := f.refresh[name.String()] == r
+	f.mutex.Unlock()
+	if !current {
+		return
+	}
+
+	if interval, e := f.announce(name); e == nil {
+		f.scheduleRefresh(name, r, interval, interval)
+	} else {
+		// retry sooner, while the previous announcement is still in effect
+		f.scheduleRefresh(name, r, r.interval, r.interval/4)
+	}
+}
+
 func (f *nfdFace) Withdraw(name ndn.Name) error {
-	cr, e := f.client.Invoke(context.TODO(), RibUnregisterCommand{
+	origin := RouteOriginClient
+	if f.client.PrefixAnnSigner != nil {
+		origin = RouteOriginPrefixAnn
+		f.cmdMutex.Lock()
+		defer f.cmdMutex.Unlock()
+
+		f.mutex.Lock()
+		if r := f.refresh[name.String()]; r != nil {
+			r.timer.Stop()
+			delete(f.refresh, name.String())
+		}
+		f.mutex.Unlock()
+	}
+	return f.invoke(RibUnregisterCommand{
 		Name:   name,
-		Origin: RouteOriginClient,
+		Origin: origin,
 	})
+}
+
+func (f *nfdFace) invoke(cmd ControlCommand) error {
+	cr, e := f.client.Invoke(context.TODO(), cmd)
 	if e != nil {
 		return e
 	}
@@ -85,7 +183,8 @@ func newNfdFace(c *Client) (f *nfdFace, e error) {
 	}
 
 	return &nfdFace{
-		client: c,
-		l3face: l3face,
+		client:  c,
+		l3face:  l3face,
+		refresh: map[string]*paRefresh{},
 	}, nil
 }
@@ -0,0 +1,136 @@
+package nfdmgmt_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/mgmt"
+	"github.com/usnistgov/ndn-dpdk/ndn/mgmt/nfdmgmt"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+	"go4.org/must"
+)
+
+type nfdMock struct {
+	mutex    sync.Mutex
+	counts   map[string]int
+	replied  []string
+	announce time.Duration // delay before replying to rib/announce
+}
+
+func (m *nfdMock) Count(verb string) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.counts[verb]
+}
+
+func (m *nfdMock) Replied() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]string{}, m.replied...)
+}
+
+// openFace starts a mock NFD and opens a face that advertises prefixes with prefix announcements.
+func (m *nfdMock) openFace(t testing.TB, expiration time.Duration) mgmt.Face {
+	_, require := makeAR(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	m.counts = map[string]int{}
+
+	listener, e := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(e)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, e := listener.Accept()
+			if e != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	t.Setenv("NDN_CLIENT_TRANSPORT", "tcp://"+listener.Addr().String())
+
+	fw := l3.NewForwarder()
+	p, e := endpoint.Produce(ctx, endpoint.ProducerOptions{
+		Prefix: nfdmgmt.PrefixLocalhost,
+		Fw:     fw,
+		Handler: func(ctx context.Context, interest ndn.Interest) (ndn.Data, error) {
+			verb := string(interest.Name[len(nfdmgmt.PrefixLocalhost)+1].Value)
+			m.mutex.Lock()
+			m.counts[verb]++
+			m.mutex.Unlock()
+
+			if verb == "announce" {
+				time.Sleep(m.announce)
+			}
+			m.mutex.Lock()
+			m.replied = append(m.replied, verb)
+			m.mutex.Unlock()
+
+			content, _ := tlv.EncodeFrom(tlv.TLV(nfdmgmt.TtControlResponse,
+				tlv.TLVNNI(nfdmgmt.TtStatusCode, 200),
+				tlv.TLVBytes(nfdmgmt.TtStatusText, []byte("OK")),
+			))
+			return ndn.MakeData(interest, content), nil
+		},
+	})
+	require.NoError(e)
+	t.Cleanup(func() { must.Close(p) })
+
+	pvt, _, e := keychain.NewECDSAKeyPair(ndn.ParseName("/K"))
+	require.NoError(e)
+	client, e := nfdmgmt.New()
+	require.NoError(e)
+	client.ConsumerOpts.Fw = fw
+	client.PrefixAnnSigner = pvt
+	client.PrefixAnnExpiration = expiration
+
+	face, e := client.OpenFace()
+	require.NoError(e)
+	t.Cleanup(func() { face.Close() })
+	return face
+}
+
+func TestFaceRefresh(t *testing.T) {
+	assert, require := makeAR(t)
+	mock := &nfdMock{}
+	face := mock.openFace(t, 200*time.Millisecond)
+
+	require.NoError(face.Advertise(ndn.ParseName("/P")))
+	assert.Equal(1, mock.Count("announce"))
+
+	time.Sleep(350 * time.Millisecond)
+	assert.GreaterOrEqual(mock.Count("announce"), 3)
+
+	require.NoError(face.Withdraw(ndn.ParseName("/P")))
+	assert.Equal(1, mock.Count("unregister"))
+	time.Sleep(50 * time.Millisecond)
+	nAnnounced := mock.Count("announce")
+	time.Sleep(250 * time.Millisecond)
+	assert.Equal(nAnnounced, mock.Count("announce"))
+}
+
+func TestFaceWithdrawDuringRefresh(t *testing.T) {
+	assert, require := makeAR(t)
+	mock := &nfdMock{announce: 100 * time.Millisecond}
+	face := mock.openFace(t, 200*time.Millisecond)
+
+	require.NoError(face.Advertise(ndn.ParseName("/P")))
+	// first refresh is sent at 100ms and answered at 200ms
+	time.Sleep(150 * time.Millisecond)
+	require.Equal(2, mock.Count("announce"))
+
+	require.NoError(face.Withdraw(ndn.ParseName("/P")))
+	replied := mock.Replied()
+	assert.Equal([]string{"announce", "announce", "unregister"}, replied)
+
+	time.Sleep(300 * time.Millisecond)
+	assert.Equal(replied, mock.Replied())
+}
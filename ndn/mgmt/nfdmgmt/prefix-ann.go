@@ -0,0 +1,168 @@
+package nfdmgmt
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// Error conditions for prefix announcement.
+var (
+	ErrPrefixAnnName        = errors.New("bad PrefixAnnouncement name")
+	ErrPrefixAnnContentType = errors.New("bad PrefixAnnouncement ContentType")
+	ErrPrefixAnnExpiration  = errors.New("bad PrefixAnnouncement ExpirationPeriod")
+	ErrPrefixAnnExpired     = errors.New("PrefixAnnouncement is outside ValidityPeriod")
+)
+
+// KeywordPA is the 32=PA component in prefix announcement name.
+var KeywordPA = ndn.MakeNameComponent(an.TtKeywordNameComponent, []byte("PA"))
+
+// PrefixAnnouncement represents a prefix announcement object.
+// https://redmine.named-data.net/projects/nfd/wiki/PrefixAnnouncement
+type PrefixAnnouncement struct {
+	data       ndn.Data
+	expiration time.Duration
+	validity   *keychain.ValidityPeriod
+}
+
+// Name returns the prefix announcement Data name.
+func (pa PrefixAnnouncement) Name() ndn.Name {
+	return pa.data.Name
+}
+
+// Data returns the prefix announcement Data packet.
+// It may be passed to a Verifier to verify the signature.
+func (pa PrefixAnnouncement) Data() ndn.Data {
+	return pa.data
+}
+
+// Prefix returns the announced name prefix.
+func (pa PrefixAnnouncement) Prefix() ndn.Name {
+	return pa.data.Name[:len(pa.data.Name)-3]
+}
+
+// Expiration returns the ExpirationPeriod.
+func (pa PrefixAnnouncement) Expiration() time.Duration {
+	return pa.expiration
+}
+
+// Validity returns the ValidityPeriod, or nil if absent.
+func (pa PrefixAnnouncement) Validity() *keychain.ValidityPeriod {
+	return pa.validity
+}
+
+// Verify verifies the signature, and checks the ValidityPeriod against the given timestamp.
+func (pa PrefixAnnouncement) Verify(verifier ndn.Verifier, now time.Time) error {
+	if e := verifier.Verify(pa.data); e != nil {
+		return e
+	}
+	if pa.validity != nil && !pa.validity.Includes(now) {
+		return ErrPrefixAnnExpired
+	}
+	return nil
+}
+
+// IsPrefixAnnName determines whether the name is a prefix announcement name.
+// It should be /<prefix>/32=PA/<version>/<segment=0>.
+func IsPrefixAnnName(name ndn.Name) bool {
+	return len(name) >= 3 && name.Get(-3).Equal(KeywordPA) &&
+		name.Get(-2).Type == an.TtVersionNameComponent && name.Get(-1).Equal(segmentZero)
+}
+
+var segmentZero = ndn.NameComponentFrom(an.TtSegmentNameComponent, tlv.NNI(0))
+
+// PrefixAnnFromData parses a Data packet as prefix announcement.
+// This does not verify the signature; use PrefixAnnouncement.Verify for that purpose.
+func PrefixAnnFromData(data ndn.Data) (pa *PrefixAnnouncement, e error) {
+	if !IsPrefixAnnName(data.Name) {
+		return nil, ErrPrefixAnnName
+	}
+	if data.ContentType != an.ContentPrefixAnn {
+		return nil, ErrPrefixAnnContentType
+	}
+
+	pa = &PrefixAnnouncement{data: data}
+	hasExpiration := false
+	d := tlv.DecodingBuffer(data.Content)
+	for _, de := range d.Elements() {
+		switch de.Type {
+		case TtExpirationPeriod:
+			pa.expiration = time.Duration(de.UnmarshalNNI(math.MaxInt64/uint64(time.Millisecond), &e, ErrPrefixAnnExpiration)) * time.Millisecond
+			if e != nil {
+				return nil, e
+			}
+			hasExpiration = true
+		case an.TtValidityPeriod:
+			pa.validity = &keychain.ValidityPeriod{}
+			if e = pa.validity.UnmarshalBinary(de.Value); e != nil {
+				return nil, e
+			}
+		default:
+			if de.IsCriticalType() {
+				return nil, tlv.ErrCritical
+			}
+		}
+	}
+	if e = d.ErrUnlessEOF(); e != nil {
+		return nil, e
+	}
+	if !hasExpiration {
+		return nil, ErrPrefixAnnExpiration
+	}
+	return pa, nil
+}
+
+// MakePrefixAnnOptions contains arguments to MakePrefixAnn function.
+type MakePrefixAnnOptions struct {
+	// Prefix is the announced name prefix.
+	Prefix ndn.Name
+
+	// Expiration is the ExpirationPeriod, i.e. route lifetime.
+	// Default is 1 hour.
+	Expiration time.Duration
+
+	// Validity is the optional ValidityPeriod.
+	Validity *keychain.ValidityPeriod
+
+	// Version is the version component.
+	// Default is based on current timestamp.
+	Version ndn.NameComponent
+}
+
+func (opts *MakePrefixAnnOptions) applyDefaults() {
+	opts.Expiration = opts.Expiration.Truncate(time.Millisecond)
+	if opts.Expiration <= 0 {
+		opts.Expiration = time.Hour
+	}
+	if !opts.Version.Valid() {
+		opts.Version = ndn.NameComponentFrom(an.TtVersionNameComponent, tlv.NNI(time.Now().UnixMilli()))
+	}
+}
+
+// MakePrefixAnn generates a prefix announcement, signed by the given signer.
+func MakePrefixAnn(signer ndn.Signer, opts MakePrefixAnnOptions) (pa *PrefixAnnouncement, e error) {
+	opts.applyDefaults()
+
+	content := []tlv.Fielder{tlv.TLVNNI(TtExpirationPeriod, uint64(opts.Expiration/time.Millisecond))}
+	if opts.Validity != nil {
+		content = append(content, *opts.Validity)
+	}
+
+	data := ndn.Data{
+		Name:        opts.Prefix.Append(KeywordPA, opts.Version, segmentZero),
+		ContentType: an.ContentPrefixAnn,
+		FinalBlock:  segmentZero,
+	}
+	if data.Content, e = tlv.EncodeFrom(content...); e != nil {
+		return nil, e
+	}
+	if e = signer.Sign(&data); e != nil {
+		return nil, e
+	}
+	return PrefixAnnFromData(data)
+}
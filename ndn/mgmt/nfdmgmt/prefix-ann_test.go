@@ -0,0 +1,78 @@
+package nfdmgmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/core/testenv"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/mgmt/nfdmgmt"
+	"github.com/usnistgov/ndn-dpdk/ndn/ndntestenv"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+var (
+	makeAR    = testenv.MakeAR
+	nameEqual = ndntestenv.NameEqual
+)
+
+func TestPrefixAnn(t *testing.T) {
+	assert, require := makeAR(t)
+
+	pvt, pub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/K"))
+	require.NoError(e)
+	now := time.Now()
+	validity := keychain.ValidityPeriod{
+		NotBefore: now.Add(-time.Hour).Truncate(time.Second),
+		NotAfter:  now.Add(time.Hour).Truncate(time.Second),
+	}
+
+	pa, e := nfdmgmt.MakePrefixAnn(pvt, nfdmgmt.MakePrefixAnnOptions{
+		Prefix:     ndn.ParseName("/P/Q"),
+		Expiration: 90 * time.Second,
+		Validity:   &validity,
+	})
+	require.NoError(e)
+	nameEqual(assert, "/P/Q", pa.Prefix())
+	assert.True(nfdmgmt.IsPrefixAnnName(pa.Name()))
+	assert.Equal(90*time.Second, pa.Expiration())
+
+	cmd := nfdmgmt.RibAnnounceCommand{PrefixAnn: *pa}
+	interest, e := nfdmgmt.BuildCommandInterest(ndn.ParseName("/localhost/nfd"), cmd)
+	require.NoError(e)
+	nameEqual(assert, "/localhost/nfd/rib/announce", interest.Name)
+
+	var pkt ndn.Packet
+	require.NoError(tlv.Decode(interest.AppParameters, &pkt))
+	require.NotNil(pkt.Data)
+	decoded, e := nfdmgmt.PrefixAnnFromData(*pkt.Data)
+	require.NoError(e)
+	nameEqual(assert, pa.Name(), decoded.Name())
+	assert.Equal(90*time.Second, decoded.Expiration())
+	require.NotNil(decoded.Validity())
+	assert.True(decoded.Validity().NotBefore.Equal(validity.NotBefore))
+	assert.True(decoded.Validity().NotAfter.Equal(validity.NotAfter))
+
+	assert.NoError(decoded.Verify(pub, now))
+	assert.ErrorIs(decoded.Verify(pub, now.Add(2*time.Hour)), nfdmgmt.ErrPrefixAnnExpired)
+
+	_, pub2, _ := keychain.NewECDSAKeyPair(ndn.ParseName("/K"))
+	assert.Error(decoded.Verify(pub2, now))
+
+	data := pa.Data()
+	data.ContentType = an.ContentBlob
+	_, e = nfdmgmt.PrefixAnnFromData(data)
+	assert.ErrorIs(e, nfdmgmt.ErrPrefixAnnContentType)
+
+	data = pa.Data()
+	data.Name = ndn.ParseName("/P/Q")
+	_, e = nfdmgmt.PrefixAnnFromData(data)
+	assert.ErrorIs(e, nfdmgmt.ErrPrefixAnnName)
+
+	data = pa.Data()
+	data.Content = nil
+	_, e = nfdmgmt.PrefixAnnFromData(data)
+	assert.ErrorIs(e, nfdmgmt.ErrPrefixAnnExpiration)
+}
@@ -1,6 +1,9 @@
 package nfdmgmt
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/usnistgov/ndn-dpdk/core/nnduration"
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
@@ -9,6 +12,7 @@ import (
 var (
 	verbRibRegister   = ndn.ParseName("/rib/register")
 	verbRibUnregister = ndn.ParseName("/rib/unregister")
+	verbRibAnnounce   = ndn.ParseName("/rib/announce")
 )
 
 func encodeRibCommonParameters(name ndn.Name, faceID int, origin int) (a []tlv.Fielder) {
@@ -78,3 +82,37 @@ func (cmd RibUnregisterCommand) Parameters() (a []tlv.Fielder) {
 	a = encodeRibCommonParameters(cmd.Name, cmd.FaceID, cmd.Origin)
 	return a
 }
+
+// RibAnnounceCommand is a NFD command to register a route via a prefix announcement.
+// The route has RouteOriginPrefixAnn and expires after the ExpirationPeriod of the prefix announcement.
+type RibAnnounceCommand struct {
+	PrefixAnn PrefixAnnouncement
+}
+
+var _ interface {
+	ControlCommand
+	AppParametersCommand
+} = RibAnnounceCommand{}
+
+// Verb returns "rib/announce".
+func (RibAnnounceCommand) Verb() []ndn.NameComponent {
+	return verbRibAnnounce
+}
+
+// Parameters returns nil, because this command does not use ControlParameters.
+func (RibAnnounceCommand) Parameters() []tlv.Fielder {
+	return nil
+}
+
+// AppParameters encodes the prefix announcement Data packet.
+func (cmd RibAnnounceCommand) AppParameters() ([]byte, error) {
+	return tlv.EncodeFrom(cmd.PrefixAnn.Data())
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (cmd RibAnnounceCommand) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"name":    cmd.PrefixAnn.Prefix(),
+		"expires": nnduration.Milliseconds(cmd.PrefixAnn.Expiration() / time.Millisecond),
+	})
+}
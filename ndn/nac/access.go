@@ -0,0 +1,90 @@
+package nac
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// AccessManager publishes a content key encrypted for authorized consumers.
+//
+// For each authorized consumer key, the content key is encrypted with the consumer public key,
+// and published as a Data packet named ckName/ENCRYPTED-BY/consumerKeyName.
+type AccessManager struct {
+	ck    *ContentKey
+	mutex sync.RWMutex
+	keys  map[string]ndn.Data
+}
+
+// NewAccessManager creates an AccessManager for a content key.
+func NewAccessManager(ck *ContentKey) *AccessManager {
+	return &AccessManager{
+		ck:   ck,
+		keys: map[string]ndn.Data{},
+	}
+}
+
+// ContentKey returns the content key.
+func (am *AccessManager) ContentKey() *ContentKey {
+	return am.ck
+}
+
+// Grant authorizes a consumer.
+// consumer must be an RSA or ECDSA public key; its name should be a key name.
+func (am *AccessManager) Grant(consumer keychain.PublicKey) error {
+	pub, e := cryptoPublicKey(consumer)
+	if e != nil {
+		return e
+	}
+	wrapped, e := wrapKey(pub, am.ck.secret)
+	if e != nil {
+		return e
+	}
+	content, e := tlv.EncodeFrom(EncryptedContent{
+		Payload: wrapped,
+		KeyName: consumer.Name(),
+	})
+	if e != nil {
+		return e
+	}
+
+	data := ndn.MakeData(MakeEncryptedKeyName(am.ck.name, consumer.Name()), content, time.Hour)
+	data.ContentType = an.ContentBlob
+
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+	am.keys[data.Name.String()] = data
+	return nil
+}
+
+// Revoke removes authorization of a consumer.
+// The consumer can still decrypt content if it has retrieved the content key previously;
+// a new content key should be used for subsequent content.
+func (am *AccessManager) Revoke(consumerKeyName ndn.Name) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+	delete(am.keys, MakeEncryptedKeyName(am.ck.name, consumerKeyName).String())
+}
+
+// Serve starts a producer that publishes encrypted content keys.
+// opts.Prefix is set to the content key name; opts.Handler is overwritten.
+// opts.DataSigner should be set, so that consumers can verify the encrypted content keys.
+func (am *AccessManager) Serve(ctx context.Context, opts endpoint.ProducerOptions) (endpoint.Producer, error) {
+	opts.Prefix = am.ck.name
+	opts.Handler = func(_ context.Context, interest ndn.Interest) (ndn.Data, error) {
+		am.mutex.RLock()
+		defer am.mutex.RUnlock()
+		data, ok := am.keys[interest.Name.String()]
+		if !ok {
+			return ndn.Data{}, endpoint.ReplyNack(an.NackNoRoute)
+		}
+		return data, nil
+	}
+	return endpoint.Produce(ctx, opts)
+}
@@ -0,0 +1,31 @@
+// Package nac implements name-based content encryption, inspired by NAC.
+// https://github.com/named-data/name-based-access-control
+//
+// Content is encrypted with an AES-256-GCM content key.
+// The content key is encrypted for each authorized consumer with RSA-OAEP or ECIES, and published by AccessManager.
+// This package uses NAC TLV-TYPE numbers and naming conventions, but its ciphers differ from ndn-nac library.
+package nac
+
+import (
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+)
+
+// TLV-TYPE assigned numbers.
+const (
+	TtEncryptedContent     = 0x82
+	TtEncryptedPayload     = 0x84
+	TtInitializationVector = 0x85
+	TtEncryptedPayloadKey  = 0x86
+)
+
+// Name components in content key naming.
+var (
+	ComponentCK          = ndn.MakeNameComponent(an.TtGenericNameComponent, []byte("CK"))
+	ComponentENCRYPTEDBY = ndn.MakeNameComponent(an.TtGenericNameComponent, []byte("ENCRYPTED-BY"))
+)
+
+// MakeEncryptedKeyName returns the name of a content key encrypted for a consumer key.
+func MakeEncryptedKeyName(ckName, consumerKeyName ndn.Name) ndn.Name {
+	return ckName.Append(ComponentENCRYPTEDBY).Append(consumerKeyName...)
+}
@@ -0,0 +1,74 @@
+package nac
+
+import (
+	"errors"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// EncryptedContent represents EncryptedContent TLV.
+type EncryptedContent struct {
+	// Payload is the ciphertext.
+	Payload []byte
+	// IV is the initialization vector, if needed by the cipher.
+	IV []byte
+	// PayloadKey is the encrypted symmetric key, if needed by the cipher.
+	PayloadKey []byte
+	// KeyName is the name of the key that can decrypt this content.
+	KeyName ndn.Name
+}
+
+var (
+	_ tlv.Fielder     = EncryptedContent{}
+	_ tlv.Unmarshaler = (*EncryptedContent)(nil)
+)
+
+// Field implements tlv.Fielder interface.
+func (ec EncryptedContent) Field() tlv.Field {
+	fields := []tlv.Fielder{tlv.TLVBytes(TtEncryptedPayload, ec.Payload)}
+	if len(ec.IV) > 0 {
+		fields = append(fields, tlv.TLVBytes(TtInitializationVector, ec.IV))
+	}
+	if len(ec.PayloadKey) > 0 {
+		fields = append(fields, tlv.TLVBytes(TtEncryptedPayloadKey, ec.PayloadKey))
+	}
+	if len(ec.KeyName) > 0 {
+		fields = append(fields, ec.KeyName)
+	}
+	return tlv.TLVFrom(TtEncryptedContent, fields...)
+}
+
+// UnmarshalTLV implements tlv.Unmarshaler interface.
+func (ec *EncryptedContent) UnmarshalTLV(typ uint32, value []byte) error {
+	if typ != TtEncryptedContent {
+		return tlv.ErrType
+	}
+
+	*ec = EncryptedContent{}
+	hasPayload := false
+	d := tlv.DecodingBuffer(value)
+	for _, de := range d.Elements() {
+		switch de.Type {
+		case TtEncryptedPayload:
+			ec.Payload, hasPayload = de.Value, true
+		case TtInitializationVector:
+			ec.IV = de.Value
+		case TtEncryptedPayloadKey:
+			ec.PayloadKey = de.Value
+		case an.TtName:
+			if e := de.UnmarshalValue(&ec.KeyName); e != nil {
+				return e
+			}
+		default:
+			if de.IsCriticalType() {
+				return tlv.ErrCritical
+			}
+		}
+	}
+	if !hasPayload {
+		return errors.New("missing EncryptedPayload")
+	}
+	return d.ErrUnlessEOF()
+}
@@ -0,0 +1,141 @@
+package nac
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// DefaultDecryptorFetchTimeout is the default DecryptorOptions.FetchTimeout.
+const DefaultDecryptorFetchTimeout = 10 * time.Second
+
+// DecryptorOptions contains arguments to NewDecryptor function.
+type DecryptorOptions struct {
+	// ConsumerOptions is used for retrieving encrypted content keys.
+	// Its Verifier should verify the AccessManager signature.
+	endpoint.ConsumerOptions
+
+	// Key is the consumer private key.
+	// It must be an RSA or ECDSA key, and its name must be a key name.
+	Key keychain.PrivateKey
+
+	// FetchTimeout limits the retrieval of a content key.
+	// A retrieval is shared among concurrent Decrypt calls, and is not canceled by their contexts.
+	// Default is DefaultDecryptorFetchTimeout.
+	FetchTimeout time.Duration
+}
+
+// Decryptor decrypts content on behalf of an authorized consumer.
+// Content keys are retrieved from the AccessManager and cached.
+type Decryptor struct {
+	opts  DecryptorOptions
+	pvt   any
+	mutex sync.Mutex
+	cks   map[string]*ckEntry
+}
+
+// ckEntry is a content key cache entry.
+// done is closed when the retrieval completes, after which ck and e are set.
+type ckEntry struct {
+	done chan struct{}
+	ck   *ContentKey
+	e    error
+}
+
+// NewDecryptor creates a Decryptor.
+func NewDecryptor(opts DecryptorOptions) (*Decryptor, error) {
+	pvt, e := cryptoPrivateKey(opts.Key)
+	if e != nil {
+		return nil, e
+	}
+	switch pvt.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrKeyType, pvt)
+	}
+	if opts.FetchTimeout <= 0 {
+		opts.FetchTimeout = DefaultDecryptorFetchTimeout
+	}
+	return &Decryptor{
+		opts: opts,
+		pvt:  pvt,
+		cks:  map[string]*ckEntry{},
+	}, nil
+}
+
+// Decrypt decrypts EncryptedContent TLV.
+func (dec *Decryptor) Decrypt(ctx context.Context, wire []byte) (plaintext []byte, e error) {
+	var ec EncryptedContent
+	if e := tlv.Decode(wire, &ec); e != nil {
+		return nil, e
+	}
+	ck, e := dec.getContentKey(ctx, ec.KeyName)
+	if e != nil {
+		return nil, e
+	}
+	return ck.decrypt(ec)
+}
+
+// getContentKey returns a cached content key, or retrieves it from the AccessManager.
+// Concurrent requests for the same content key share one retrieval.
+func (dec *Decryptor) getContentKey(ctx context.Context, ckName ndn.Name) (*ContentKey, error) {
+	key := ckName.String()
+	dec.mutex.Lock()
+	entry := dec.cks[key]
+	if entry == nil {
+		entry = &ckEntry{done: make(chan struct{})}
+		dec.cks[key] = entry
+		go dec.retrieve(key, ckName, entry)
+	}
+	dec.mutex.Unlock()
+
+	select {
+	case <-entry.done:
+		return entry.ck, entry.e
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// retrieve performs a shared content key retrieval, detached from the contexts of its requesters.
+func (dec *Decryptor) retrieve(key string, ckName ndn.Name, entry *ckEntry) {
+	ctx, cancel := context.WithTimeout(context.Background(), dec.opts.FetchTimeout)
+	defer cancel()
+
+	entry.ck, entry.e = dec.fetchContentKey(ctx, ckName)
+	if entry.e != nil {
+		dec.mutex.Lock()
+		delete(dec.cks, key)
+		dec.mutex.Unlock()
+	}
+	close(entry.done)
+}
+
+func (dec *Decryptor) fetchContentKey(ctx context.Context, ckName ndn.Name) (*ContentKey, error) {
+	interest := ndn.MakeInterest(MakeEncryptedKeyName(ckName, dec.opts.Key.Name()))
+	data, e := endpoint.Consume(ctx, interest, dec.opts.ConsumerOptions)
+	if e != nil {
+		return nil, e
+	}
+
+	var ec EncryptedContent
+	if e := tlv.Decode(data.Content, &ec); e != nil {
+		return nil, e
+	}
+	if !ec.KeyName.Equal(dec.opts.Key.Name()) {
+		return nil, ErrKeyName
+	}
+	secret, e := unwrapKey(dec.pvt, ec.Payload)
+	if e != nil {
+		return nil, e
+	}
+	return makeContentKey(ckName, secret)
+}
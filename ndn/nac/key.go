@@ -0,0 +1,197 @@
+package nac
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// Error conditions.
+var (
+	ErrKeyType = errors.New("key type not supported for encryption")
+	ErrKeyName = errors.New("content encrypted by a different key")
+)
+
+// ContentKeyLen is the length of a content key.
+const ContentKeyLen = 32
+
+// ContentKey is an AES-256-GCM symmetric key that encrypts content.
+type ContentKey struct {
+	name   ndn.Name
+	secret []byte
+	aead   cipher.AEAD
+}
+
+// NewContentKey generates a random content key named prefix/CK/<random>.
+func NewContentKey(prefix ndn.Name) (*ContentKey, error) {
+	secret := make([]byte, ContentKeyLen)
+	rand.Read(secret)
+	id := make([]byte, 8)
+	rand.Read(id)
+	return makeContentKey(prefix.Append(ComponentCK, ndn.MakeNameComponent(an.TtGenericNameComponent, id)), secret)
+}
+
+func makeContentKey(name ndn.Name, secret []byte) (*ContentKey, error) {
+	block, e := aes.NewCipher(secret)
+	if e != nil {
+		return nil, e
+	}
+	aead, e := cipher.NewGCM(block)
+	if e != nil {
+		return nil, e
+	}
+	return &ContentKey{
+		name:   name,
+		secret: secret,
+		aead:   aead,
+	}, nil
+}
+
+// Name returns the content key name.
+func (ck *ContentKey) Name() ndn.Name {
+	return ck.name
+}
+
+// Encrypt encrypts plaintext into EncryptedContent TLV.
+func (ck *ContentKey) Encrypt(plaintext []byte) (wire []byte, e error) {
+	iv := make([]byte, ck.aead.NonceSize())
+	rand.Read(iv)
+	return tlv.EncodeFrom(EncryptedContent{
+		Payload: ck.aead.Seal(nil, iv, plaintext, nil),
+		IV:      iv,
+		KeyName: ck.name,
+	})
+}
+
+// Decrypt decrypts EncryptedContent TLV.
+func (ck *ContentKey) Decrypt(wire []byte) (plaintext []byte, e error) {
+	var ec EncryptedContent
+	if e := tlv.Decode(wire, &ec); e != nil {
+		return nil, e
+	}
+	return ck.decrypt(ec)
+}
+
+func (ck *ContentKey) decrypt(ec EncryptedContent) (plaintext []byte, e error) {
+	if !ec.KeyName.Equal(ck.name) {
+		return nil, ErrKeyName
+	}
+	if len(ec.IV) != ck.aead.NonceSize() {
+		return nil, errors.New("bad InitializationVector")
+	}
+	return ck.aead.Open(nil, ec.IV, ec.Payload, nil)
+}
+
+// cryptoPublicKey extracts the crypto public key from a keychain public key.
+func cryptoPublicKey(pub keychain.PublicKey) (any, error) {
+	spki, e := pub.SPKI()
+	if e != nil {
+		return nil, e
+	}
+	return x509.ParsePKIXPublicKey(spki)
+}
+
+// cryptoPrivateKey extracts the crypto private key from a keychain private key.
+func cryptoPrivateKey(pvt keychain.PrivateKey) (any, error) {
+	wire, e := keychain.MarshalKey(pvt)
+	if e != nil {
+		return nil, e
+	}
+	d := tlv.DecodingBuffer(wire)
+	if _, e := d.Element(); e != nil {
+		return nil, e
+	}
+	return x509.ParsePKCS8PrivateKey(d.Rest())
+}
+
+// wrapKey encrypts a content key secret for a consumer public key.
+//   - RSA: RSA-OAEP with SHA-256.
+//   - ECDSA: ECIES, consisting of ephemeral ECDH on the same curve, SHA-256 based key derivation,
+//     and AES-256-GCM; the output is ephemeral public key, nonce, and ciphertext.
+func wrapKey(pub any, secret []byte) ([]byte, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, secret, nil)
+	case *ecdsa.PublicKey:
+		recipient, e := pub.ECDH()
+		if e != nil {
+			return nil, e
+		}
+		ephemeral, e := recipient.Curve().GenerateKey(rand.Reader)
+		if e != nil {
+			return nil, e
+		}
+		aead, e := eciesKEK(ephemeral, recipient, ephemeral.PublicKey())
+		if e != nil {
+			return nil, e
+		}
+		nonce := make([]byte, aead.NonceSize())
+		rand.Read(nonce)
+		output := append(ephemeral.PublicKey().Bytes(), nonce...)
+		return aead.Seal(output, nonce, secret, nil), nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrKeyType, pub)
+}
+
+// unwrapKey decrypts a content key secret with a consumer private key.
+func unwrapKey(pvt any, wrapped []byte) ([]byte, error) {
+	switch pvt := pvt.(type) {
+	case *rsa.PrivateKey:
+		return rsa.DecryptOAEP(sha256.New(), rand.Reader, pvt, wrapped, nil)
+	case *ecdsa.PrivateKey:
+		recipient, e := pvt.ECDH()
+		if e != nil {
+			return nil, e
+		}
+		pubLen := len(recipient.PublicKey().Bytes())
+		if len(wrapped) < pubLen {
+			return nil, errors.New("bad ECIES ciphertext")
+		}
+		ephemeral, e := recipient.Curve().NewPublicKey(wrapped[:pubLen])
+		if e != nil {
+			return nil, e
+		}
+		aead, e := eciesKEK(recipient, ephemeral, ephemeral)
+		if e != nil {
+			return nil, e
+		}
+		rest := wrapped[pubLen:]
+		if len(rest) < aead.NonceSize() {
+			return nil, errors.New("bad ECIES ciphertext")
+		}
+		return aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], nil)
+	}
+	return nil, fmt.Errorf("%w: %T", ErrKeyType, pvt)
+}
+
+// eciesKEK derives the key-encryption key from ECDH shared secret.
+// This is the single-step key derivation function in NIST SP 800-56C with SHA-256,
+// using the ephemeral public key as OtherInfo.
+func eciesKEK(pvt *ecdh.PrivateKey, peer, ephemeral *ecdh.PublicKey) (cipher.AEAD, error) {
+	z, e := pvt.ECDH(peer)
+	if e != nil {
+		return nil, e
+	}
+	h := sha256.New()
+	binary.Write(h, binary.BigEndian, uint32(1))
+	h.Write(z)
+	h.Write(ephemeral.Bytes())
+	block, e := aes.NewCipher(h.Sum(nil))
+	if e != nil {
+		return nil, e
+	}
+	return cipher.NewGCM(block)
+}
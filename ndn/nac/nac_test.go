@@ -0,0 +1,234 @@
+package nac_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/core/testenv"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/nac"
+	"github.com/usnistgov/ndn-dpdk/ndn/segmented"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+	"go4.org/must"
+)
+
+var makeAR = testenv.MakeAR
+
+func TestContentKey(t *testing.T) {
+	assert, require := makeAR(t)
+
+	ck, e := nac.NewContentKey(ndn.ParseName("/P"))
+	require.NoError(e)
+	assert.Equal(3, len(ck.Name()))
+	assert.True(ck.Name().Get(1).Equal(nac.ComponentCK))
+
+	plaintext := []byte("hello NAC")
+	wire, e := ck.Encrypt(plaintext)
+	require.NoError(e)
+
+	var ec nac.EncryptedContent
+	require.NoError(tlv.Decode(wire, &ec))
+	assert.True(ec.KeyName.Equal(ck.Name()))
+	assert.Len(ec.IV, 12)
+	assert.NotContains(string(ec.Payload), string(plaintext))
+
+	decrypted, e := ck.Decrypt(wire)
+	require.NoError(e)
+	assert.Equal(plaintext, decrypted)
+
+	ec.Payload[0] ^= 0xFF
+	tampered, e := tlv.EncodeFrom(ec)
+	require.NoError(e)
+	_, e = ck.Decrypt(tampered)
+	assert.Error(e)
+
+	ck2, e := nac.NewContentKey(ndn.ParseName("/P"))
+	require.NoError(e)
+	_, e = ck2.Decrypt(wire)
+	assert.ErrorIs(e, nac.ErrKeyName)
+}
+
+func TestServeFetch(t *testing.T) {
+	assert, require := makeAR(t)
+	fw := l3.NewForwarder()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	amPvt, amPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/P/am"))
+	require.NoError(e)
+	ecPvt, ecPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/C/ec"))
+	require.NoError(e)
+	rsaPvt, rsaPub, e := keychain.NewRSAKeyPair(ndn.ParseName("/C/rsa"))
+	require.NoError(e)
+	otherPvt, _, e := keychain.NewECDSAKeyPair(ndn.ParseName("/C/other"))
+	require.NoError(e)
+	edPvt, edPub, e := keychain.NewEd25519KeyPair(ndn.ParseName("/C/ed"))
+	require.NoError(e)
+
+	ck, e := nac.NewContentKey(ndn.ParseName("/P"))
+	require.NoError(e)
+	am := nac.NewAccessManager(ck)
+	require.NoError(am.Grant(ecPub))
+	require.NoError(am.Grant(rsaPub))
+	assert.ErrorIs(am.Grant(edPub), nac.ErrKeyType)
+	amP, e := am.Serve(ctx, endpoint.ProducerOptions{Fw: fw, DataSigner: amPvt})
+	require.NoError(e)
+	defer must.Close(amP)
+
+	plaintext := make([]byte, 20000)
+	testenv.RandBytes(plaintext)
+	sP, e := nac.ServeEncrypted(ctx, ck, plaintext, segmented.ServeOptions{
+		ProducerOptions: endpoint.ProducerOptions{
+			Prefix:     ndn.ParseName("/P/dataset"),
+			Fw:         fw,
+			DataSigner: ndn.DigestSigning,
+		},
+		ChunkSize: 3000,
+	})
+	require.NoError(e)
+	defer must.Close(sP)
+
+	fetch := func(key keychain.PrivateKey, timeout time.Duration) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		dec, e := nac.NewDecryptor(nac.DecryptorOptions{
+			ConsumerOptions: endpoint.ConsumerOptions{Fw: fw, Verifier: amPub},
+			Key:             key,
+		})
+		if e != nil {
+			return nil, e
+		}
+		return dec.FetchDecrypt(ctx, ndn.ParseName("/P/dataset"), segmented.FetchOptions{
+			Fw:       fw,
+			Verifier: ndn.DigestSigning,
+		})
+	}
+
+	decrypted, e := fetch(ecPvt, 5*time.Second)
+	require.NoError(e)
+	assert.Equal(plaintext, decrypted)
+
+	decrypted, e = fetch(rsaPvt, 5*time.Second)
+	require.NoError(e)
+	assert.Equal(plaintext, decrypted)
+
+	_, e = fetch(otherPvt, 500*time.Millisecond)
+	assert.Error(e)
+	_, e = fetch(edPvt, 500*time.Millisecond)
+	assert.ErrorIs(e, nac.ErrKeyType)
+
+	am.Revoke(ecPvt.Name())
+	_, e = fetch(ecPvt, 500*time.Millisecond)
+	assert.Error(e)
+}
+
+type countingVerifier struct {
+	ndn.Verifier
+	n     atomic.Int32
+	delay time.Duration
+}
+
+func (v *countingVerifier) Verify(packet ndn.Verifiable) error {
+	v.n.Add(1)
+	time.Sleep(v.delay)
+	return v.Verifier.Verify(packet)
+}
+
+func TestDecryptorConcurrent(t *testing.T) {
+	assert, require := makeAR(t)
+	fw := l3.NewForwarder()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	amPvt, amPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/P/am"))
+	require.NoError(e)
+	ecPvt, ecPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/C/ec"))
+	require.NoError(e)
+
+	ck, e := nac.NewContentKey(ndn.ParseName("/P"))
+	require.NoError(e)
+	am := nac.NewAccessManager(ck)
+	require.NoError(am.Grant(ecPub))
+	amP, e := am.Serve(ctx, endpoint.ProducerOptions{Fw: fw, DataSigner: amPvt})
+	require.NoError(e)
+	defer must.Close(amP)
+
+	plaintext := []byte("hello NAC")
+	wire, e := ck.Encrypt(plaintext)
+	require.NoError(e)
+
+	verifier := &countingVerifier{Verifier: amPub}
+	dec, e := nac.NewDecryptor(nac.DecryptorOptions{
+		ConsumerOptions: endpoint.ConsumerOptions{Fw: fw, Verifier: verifier},
+		Key:             ecPvt,
+	})
+	require.NoError(e)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decrypted, e := dec.Decrypt(ctx, wire)
+			assert.NoError(e)
+			assert.Equal(plaintext, decrypted)
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(1, verifier.n.Load())
+}
+
+func TestDecryptorCancel(t *testing.T) {
+	assert, require := makeAR(t)
+	fw := l3.NewForwarder()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	amPvt, amPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/P/am"))
+	require.NoError(e)
+	ecPvt, ecPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/C/ec"))
+	require.NoError(e)
+
+	ck, e := nac.NewContentKey(ndn.ParseName("/P"))
+	require.NoError(e)
+	am := nac.NewAccessManager(ck)
+	require.NoError(am.Grant(ecPub))
+	amP, e := am.Serve(ctx, endpoint.ProducerOptions{Fw: fw, DataSigner: amPvt})
+	require.NoError(e)
+	defer must.Close(amP)
+
+	plaintext := []byte("hello NAC")
+	wire, e := ck.Encrypt(plaintext)
+	require.NoError(e)
+
+	verifier := &countingVerifier{Verifier: amPub, delay: 200 * time.Millisecond}
+	dec, e := nac.NewDecryptor(nac.DecryptorOptions{
+		ConsumerOptions: endpoint.ConsumerOptions{Fw: fw, Verifier: verifier},
+		Key:             ecPvt,
+	})
+	require.NoError(e)
+
+	// first requester gives up, but the shared retrieval continues for the second requester
+	ctx1, cancel1 := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel1()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, e := dec.Decrypt(ctx1, wire)
+		assert.ErrorIs(e, context.DeadlineExceeded)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	decrypted, e := dec.Decrypt(ctx, wire)
+	assert.NoError(e)
+	assert.Equal(plaintext, decrypted)
+	wg.Wait()
+	assert.EqualValues(1, verifier.n.Load())
+}
@@ -0,0 +1,28 @@
+package nac
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/segmented"
+)
+
+// ServeEncrypted encrypts an object with a content key, and publishes the EncryptedContent as a segmented object.
+func ServeEncrypted(ctx context.Context, ck *ContentKey, plaintext []byte, opts segmented.ServeOptions) (endpoint.Producer, error) {
+	wire, e := ck.Encrypt(plaintext)
+	if e != nil {
+		return nil, e
+	}
+	return segmented.Serve(ctx, bytes.NewReader(wire), opts)
+}
+
+// FetchDecrypt retrieves a segmented object published by ServeEncrypted, and decrypts it.
+func (dec *Decryptor) FetchDecrypt(ctx context.Context, name ndn.Name, opts segmented.FetchOptions) (plaintext []byte, e error) {
+	wire, e := segmented.Fetch(name, opts).Payload(ctx)
+	if e != nil {
+		return nil, e
+	}
+	return dec.Decrypt(ctx, wire)
+}
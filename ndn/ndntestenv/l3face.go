@@ -19,6 +19,10 @@ type L3FaceTester struct {
 	LossTolerance    float64
 	InterestInterval time.Duration
 	CloseDelay       time.Duration
+
+	// DataPayloadLen is the Content length of each Data packet.
+	// Setting this above transport MTU causes the Data packets to be fragmented.
+	DataPayloadLen int
 }
 
 func (c *L3FaceTester) applyDefaults() {
@@ -87,7 +91,7 @@ func (c *L3FaceTester) CheckL3Face(t testing.TB, faceA, faceB l3.Face) {
 					nack := ndn.MakeNack(*packet.Interest)
 					txB <- nack
 				} else {
-					data := ndn.MakeData(*packet.Interest)
+					data := ndn.MakeData(*packet.Interest, make([]byte, c.DataPayloadLen))
 					txB <- data
 				}
 			}
@@ -105,7 +109,9 @@ func (c *L3FaceTester) CheckL3Face(t testing.TB, faceA, faceB l3.Face) {
 				assert.NotNil(packet.Nack)
 				nNacks++
 			} else {
-				assert.NotNil(packet.Data)
+				if assert.NotNil(packet.Data) {
+					assert.Len(packet.Data.Content, c.DataPayloadLen)
+				}
 				nData++
 			}
 
@@ -136,4 +142,10 @@ func (c *L3FaceTester) CheckL3Face(t testing.TB, faceA, faceB l3.Face) {
 	wg.Wait()
 	assert.InEpsilon(c.Count, nData+nNacks, c.LossTolerance)
 	assert.InEpsilon(c.Count/5, nNacks, c.LossTolerance)
+
+	if c.DataPayloadLen > faceA.Transport().MTU() {
+		cnt := faceA.ReassemblerCounters()
+		assert.EqualValues(nData, cnt.NDeliverPackets)
+		assert.Greater(cnt.NDeliverFragments, cnt.NDeliverPackets)
+	}
 }
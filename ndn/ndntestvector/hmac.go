@@ -0,0 +1,17 @@
+package ndntestvector
+
+// HMAC-SHA256 samples with secret "Jefe" (RFC 4231 test case 2), signed with Python hmac module.
+var (
+	HMACSecret = []byte("Jefe")
+
+	// HMACKeyDigestDemo is Data /hmac/demo signed with KeyLocator KeyDigest.
+	HMACKeyDigestDemo = makeDataFromBase64(`
+		BmAHDAgEaG1hYwgEZGVtbxUFaGVsbG8WJxsBBBwiHSAAVyW0hgnEXmuSBbf/AnnZ
+		24MKHpwdoFguiiSia4YXABcgQZVYVqHJ1+y5Y7gJrXr2tAAoYTV9JL1zeKhzZz8a
+		csE=`)
+
+	// HMACKeyNameDemo is Data /hmac/demo signed with KeyLocator Name /hmac/KEY/Jefe.
+	HMACKeyNameDemo = makeDataFromBase64(`
+		BlEHDAgEaG1hYwgEZGVtbxUFaGVsbG8WGBsBBBwTBxEIBGhtYWMIA0tFWQgESmVm
+		ZRcgWl4+ju6MSo39SOKxgOTj9izL0g6eYlROu7o+9WJFdog=`)
+)
@@ -25,6 +25,8 @@ var LpDecodeTests = []struct {
 	PitToken   []byte
 	NackReason uint8
 	CongMark   uint8
+	NextHop    uint16
+	Incoming   uint16
 	PayloadL   int
 }{
 	{Input: "", Bad: true},
@@ -58,4 +60,9 @@ var LpDecodeTests = []struct {
 		NackReason: an.NackNoRoute, PayloadL: payloadInterestL},
 	{Input: "640E congmark=FD03400104 payload=" + payloadInterest,
 		CongMark: 4, PayloadL: payloadInterestL},
+	{Input: "640F nexthop=FD03300201F4 payload=" + payloadInterest,
+		NextHop: 500, PayloadL: payloadInterestL},
+	{Input: "6414 incoming=FD032C0103 nexthop=FD03300205DC payload=" + payloadInterest,
+		Incoming: 3, NextHop: 1500, PayloadL: payloadInterestL},
+	{Input: "6411 nexthop=FD033004000186A0 payload=" + payloadInterest, Bad: true}, // exceeds uint16
 }
@@ -167,6 +167,14 @@ func (pkt *Packet) decodeValue(value []byte) (e error) {
 			if e = d1.ErrUnlessEOF(); e != nil {
 				return e
 			}
+		case an.TtIncomingFaceID:
+			if pkt.Lp.IncomingFaceID = de.UnmarshalNNI(math.MaxUint64, &e, tlv.ErrRange); e != nil {
+				return e
+			}
+		case an.TtNextHopFaceID:
+			if pkt.Lp.NextHopFaceID = de.UnmarshalNNI(math.MaxUint64, &e, tlv.ErrRange); e != nil {
+				return e
+			}
 		case an.TtCongestionMark:
 			if pkt.Lp.CongMark = uint8(de.UnmarshalNNI(math.MaxUint8, &e, tlv.ErrRange)); e != nil {
 				return e
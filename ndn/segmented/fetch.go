@@ -4,6 +4,8 @@ package segmented
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -14,6 +16,7 @@ import (
 	"github.com/usnistgov/ndn-dpdk/ndn/an"
 	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
 	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/rdr"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
 	"github.com/zyedidia/generic"
 )
@@ -57,6 +60,11 @@ type FetchOptions struct {
 	// Verifier is a public key to verify Data.
 	// Default is NopVerifier.
 	Verifier ndn.Verifier `json:"-"`
+
+	// Manifest enables manifest-based verification.
+	// If true, the manifest published by Serve with Manifest option is retrieved and verified by Verifier,
+	// and each segment is verified by its implicit digest in the manifest.
+	Manifest bool `json:"manifest,omitempty"`
 }
 
 func (opts *FetchOptions) applyDefaults() {
@@ -103,11 +111,51 @@ func Fetch(name ndn.Name, opts FetchOptions) FetchResult {
 	}
 }
 
+// FetchLatest retrieves the latest version of a segmented object.
+// The versioned name is discovered via RDR metadata, which is verified by opts.Verifier.
+func FetchLatest(ctx context.Context, prefix ndn.Name, opts FetchOptions) (FetchResult, error) {
+	var m rdr.Metadata
+	if e := rdr.RetrieveMetadata(ctx, &m, prefix, endpoint.ConsumerOptions{
+		Fw:       opts.Fw,
+		Verifier: opts.Verifier,
+	}); e != nil {
+		return nil, e
+	}
+	return Fetch(m.Name, opts), nil
+}
+
 type fetcher struct {
 	FetchOptions
 	prefix     ndn.Name
 	count      int
 	finalBlock uint64
+	digests    [][]byte
+}
+
+func (f *fetcher) fetchManifest(ctx context.Context) error {
+	mOpts := f.FetchOptions
+	mOpts.SegmentRange = SegmentRange{}
+	mOpts.Manifest = false
+	manifest, e := Fetch(f.prefix.Append(KeywordManifest), mOpts).Payload(ctx)
+	if e != nil {
+		return fmt.Errorf("manifest: %w", e)
+	}
+	if len(manifest) == 0 || len(manifest)%sha256.Size != 0 {
+		return errors.New("manifest: bad length")
+	}
+	for len(manifest) > 0 {
+		f.digests = append(f.digests, manifest[:sha256.Size])
+		manifest = manifest[sha256.Size:]
+	}
+	f.finalBlock = uint64(len(f.digests))
+	return nil
+}
+
+func (f *fetcher) verify(seg uint64, data *ndn.Data) bool {
+	if f.Manifest {
+		return seg < uint64(len(f.digests)) && bytes.Equal(data.ComputeDigest(), f.digests[seg])
+	}
+	return f.Verifier.Verify(data) == nil
 }
 
 func (f *fetcher) makeInterest(seg uint64) ndn.Interest {
@@ -117,6 +165,12 @@ func (f *fetcher) makeInterest(seg uint64) ndn.Interest {
 
 func (f *fetcher) Unordered(ctx context.Context, unordered chan<- *ndn.Data) error {
 	defer close(unordered)
+	if f.Manifest {
+		if e := f.fetchManifest(ctx); e != nil {
+			return e
+		}
+	}
+
 	face, e := endpoint.NewLFace(f.Fw)
 	if e != nil {
 		return e
@@ -129,6 +183,9 @@ func (f *fetcher) Unordered(ctx context.Context, unordered chan<- *ndn.Data) err
 	retxQ := makeRetxQueue()
 	ticker := time.NewTicker(time.Millisecond)
 	segNext, segLast := f.SegmentBegin, f.SegmentEnd-1
+	if f.Manifest {
+		segLast = generic.Min(segLast, uint64(len(f.digests))-1)
+	}
 	defer ticker.Stop()
 
 	for {
@@ -141,13 +198,13 @@ func (f *fetcher) Unordered(ctx context.Context, unordered chan<- *ndn.Data) err
 
 		case l3pkt := <-face.Rx():
 			pkt := l3pkt.ToPacket()
-			if pkt.Data == nil || f.Verifier.Verify(pkt.Data) != nil {
+			if pkt.Data == nil {
 				break
 			}
 			now := time.Now()
 
 			seg, ok := extractSegment(pkt.Data.Name, len(f.prefix))
-			if !ok || !f.prefix.IsPrefixOf(pkt.Data.Name) {
+			if !ok || !f.prefix.IsPrefixOf(pkt.Data.Name) || !f.verify(seg, pkt.Data) {
 				break
 			}
 			fs, ok := pendings[seg]
@@ -143,3 +143,42 @@ func TestEmpty(t *testing.T) {
 	require.NoError(e)
 	require.Len(payload, 0)
 }
+
+func TestVersionedManifest(t *testing.T) {
+	assert, require := makeAR(t)
+	fixture := NewServeFetchFixture(t)
+	fixture.EnableBridge()
+
+	fixture.Prepare(20000, 1000)
+	fixture.SOpt.Version = segmented.MakeVersionComponent()
+	fixture.SOpt.Metadata = true
+	fixture.SOpt.Manifest = true
+	fixture.SOpt.DataSigner = ndn.DigestSigning
+	fixture.FOpt.Verifier = ndn.DigestSigning
+	fixture.FOpt.Manifest = true
+	defer fixture.Serve()()
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	f, e := segmented.FetchLatest(ctx, fixture.SOpt.Prefix, fixture.FOpt)
+	require.NoError(e)
+	pkts, e := f.Packets(ctx)
+	require.NoError(e)
+	require.Len(pkts, 20)
+	assert.Equal(20, f.Count())
+	for i, pkt := range pkts {
+		assert.Len(pkt.Name, 3)
+		assert.True(pkt.Name.Get(1).Equal(fixture.SOpt.Version))
+		assert.Equal(fixture.Payload[i*1000:(i+1)*1000], pkt.Content)
+		assert.Error(ndn.DigestSigning.Verify(pkt), "segments are not signed")
+	}
+
+	vName := fixture.SOpt.Prefix.Append(fixture.SOpt.Version)
+	fOpt := fixture.FOpt
+	fOpt.Manifest = false
+	ctx1, cancel1 := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancel1()
+	_, e = segmented.Fetch(vName, fOpt).Payload(ctx1)
+	assert.Error(e)
+}
@@ -1,6 +1,7 @@
 package segmented
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"github.com/usnistgov/ndn-dpdk/ndn"
 	"github.com/usnistgov/ndn-dpdk/ndn/an"
 	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/rdr"
 	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
 )
 
@@ -33,6 +35,9 @@ func extractSegment(name ndn.Name, prefixLen int) (segment uint64, ok bool) {
 	return uint64(value), true
 }
 
+// KeywordManifest is the 32=manifest component.
+var KeywordManifest = ndn.MakeNameComponent(an.TtKeywordNameComponent, []byte("manifest"))
+
 // ServeOptions contains options for Serve function.
 type ServeOptions struct {
 	// ProducerOptions includes prefix, L3 forwarder, signer, etc.
@@ -50,6 +55,20 @@ type ServeOptions struct {
 	// ChunkSize is Data payload length.
 	// Default is 4096.
 	ChunkSize int
+
+	// Version is a version component appended to Prefix.
+	// If specified, segments are named Prefix/Version/segment.
+	// Default is no version component.
+	Version ndn.NameComponent
+
+	// Metadata enables answering RDR discovery Interests for Prefix/32=metadata.
+	// The metadata packet contains the versioned name and is signed by DataSigner.
+	Metadata bool
+
+	// Manifest enables publishing a manifest at Prefix/Version/32=manifest, as a segmented object.
+	// The manifest contains implicit digests of all segments, and is signed by DataSigner.
+	// In this case, segments are not signed, and the source is read in full when Serve is called.
+	Manifest bool
 }
 
 func (opts *ServeOptions) applyDefaults() {
@@ -59,52 +78,162 @@ func (opts *ServeOptions) applyDefaults() {
 	}
 }
 
+// MakeVersionComponent creates a version component from current time.
+func MakeVersionComponent() ndn.NameComponent {
+	return ndn.NameComponentFrom(an.TtVersionNameComponent, tlv.NNI(time.Now().UnixMicro()))
+}
+
+type server struct {
+	ServeOptions
+	source    io.ReaderAt
+	signer    ndn.Signer
+	vPrefix   ndn.Name
+	dataTpl   ndn.Data
+	segments  []ndn.Data
+	mPrefix   ndn.Name
+	mTpl      ndn.Data
+	manifest  []byte
+	metaName  ndn.Name
+	metaValue []byte
+}
+
 // Serve publishes a segmented object.
 func Serve(ctx context.Context, source io.ReaderAt, opts ServeOptions) (endpoint.Producer, error) {
 	opts.applyDefaults()
-	prefixLen := len(opts.Prefix)
-
-	dataTpl := ndn.Data{
-		ContentType: opts.ContentType,
-		Freshness:   opts.Freshness,
+	s := &server{
+		ServeOptions: opts,
+		source:       source,
+		signer:       opts.DataSigner,
+		vPrefix:      opts.Prefix,
+		dataTpl: ndn.Data{
+			ContentType: opts.ContentType,
+			Freshness:   opts.Freshness,
+		},
+	}
+	if opts.Version.Valid() {
+		s.vPrefix = opts.Prefix.Append(opts.Version)
 	}
 	if seeker, ok := source.(io.Seeker); ok {
 		if size, e := seeker.Seek(0, io.SeekEnd); e == nil {
-			nSegs := (uint64(size) + uint64(opts.ChunkSize) - 1) / uint64(opts.ChunkSize)
-			dataTpl.FinalBlock = makeSegmentNameComponent(nSegs - 1)
+			s.dataTpl.FinalBlock = s.lastSegmentComponent(size)
+		}
+	}
+
+	if opts.Metadata {
+		s.metaName = opts.Prefix.Append(rdr.KeywordMetadata)
+		var e error
+		if s.metaValue, e = (rdr.Metadata{Name: s.vPrefix}).MarshalBinary(); e != nil {
+			return nil, e
+		}
+	}
+	if opts.Manifest {
+		if e := s.prepareManifest(); e != nil {
+			return nil, e
+		}
+	}
+
+	// Data are signed in the handler, because segments are unsigned when a manifest is published.
+	opts.DataSigner = nil
+	opts.Handler = s.handle
+	return endpoint.Produce(ctx, opts.ProducerOptions)
+}
+
+func (s *server) lastSegmentComponent(size int64) ndn.NameComponent {
+	nSegs := (uint64(size) + uint64(s.ChunkSize) - 1) / uint64(s.ChunkSize)
+	return makeSegmentNameComponent(nSegs - 1)
+}
+
+func (s *server) prepareManifest() error {
+	for seg := uint64(0); ; seg++ {
+		data, e := s.makeSegment(s.dataTpl, s.vPrefix, seg, s.source)
+		if errors.Is(e, io.EOF) {
+			break
+		} else if e != nil {
+			return e
 		}
+		s.segments = append(s.segments, data)
+		s.manifest = append(s.manifest, data.ComputeDigest()...)
+		if data.IsFinalBlock() {
+			break
+		}
+	}
+
+	s.mPrefix = s.vPrefix.Append(KeywordManifest)
+	s.mTpl = ndn.Data{
+		Freshness:  s.Freshness,
+		FinalBlock: s.lastSegmentComponent(int64(len(s.manifest))),
 	}
+	return nil
+}
+
+func (s *server) handle(_ context.Context, interest ndn.Interest) (data ndn.Data, e error) {
+	switch {
+	case s.Metadata && rdr.IsDiscoveryInterest(interest) && interest.Name.Equal(s.metaName):
+		data = ndn.MakeData(s.metaName.Append(MakeVersionComponent(), makeSegmentNameComponent(0)), s.metaValue, time.Millisecond)
+		data.FinalBlock = data.Name.Get(-1)
+		return s.sign(data)
 
-	opts.Handler = func(_ context.Context, interest ndn.Interest) (data ndn.Data, e error) {
-		seg, ok := extractSegment(interest.Name, prefixLen)
+	case s.Manifest && s.mPrefix.IsPrefixOf(interest.Name):
+		seg, ok := extractSegment(interest.Name, len(s.mPrefix))
 		if !ok {
 			return data, errors.New("segment component not found")
 		}
+		if data, e = s.makeSegment(s.mTpl, s.mPrefix, seg, bytes.NewReader(s.manifest)); e != nil {
+			return data, e
+		}
+		return s.sign(data)
 
-		data = dataTpl
-		data.Name = interest.Name
-
-		payload := make([]byte, opts.ChunkSize+1)
-		n, e := source.ReadAt(payload, int64(seg)*int64(opts.ChunkSize))
-		switch n {
-		case 0:
-			if errors.Is(e, io.EOF) {
-				e = nil
-			}
-			if seg == 0 {
-				data.FinalBlock = data.Name[prefixLen]
-			} else {
-				e = io.EOF
+	case s.vPrefix.IsPrefixOf(interest.Name):
+		seg, ok := extractSegment(interest.Name, len(s.vPrefix))
+		if !ok {
+			return data, errors.New("segment component not found")
+		}
+		if s.Manifest {
+			if seg >= uint64(len(s.segments)) {
+				return data, io.EOF
 			}
+			return s.segments[seg], nil
+		}
+		if data, e = s.makeSegment(s.dataTpl, s.vPrefix, seg, s.source); e != nil {
 			return data, e
-		case opts.ChunkSize + 1:
-			data.Content = payload[:opts.ChunkSize]
-		default:
-			data.Content = payload[:n]
-			data.FinalBlock = data.Name[prefixLen]
 		}
+		return s.sign(data)
+	}
+	return data, errors.New("unrecognized Interest name")
+}
+
+func (s *server) sign(data ndn.Data) (ndn.Data, error) {
+	if s.signer == nil {
 		return data, nil
 	}
+	e := s.signer.Sign(&data)
+	return data, e
+}
 
-	return endpoint.Produce(ctx, opts.ProducerOptions)
+// makeSegment creates an unsigned segment Data packet.
+func (s *server) makeSegment(tpl ndn.Data, prefix ndn.Name, seg uint64, source io.ReaderAt) (data ndn.Data, e error) {
+	prefixLen := len(prefix)
+	data = tpl
+	data.Name = prefix.Append(makeSegmentNameComponent(seg))
+
+	payload := make([]byte, s.ChunkSize+1)
+	n, e := source.ReadAt(payload, int64(seg)*int64(s.ChunkSize))
+	switch n {
+	case 0:
+		if errors.Is(e, io.EOF) {
+			e = nil
+		}
+		if seg == 0 {
+			data.FinalBlock = data.Name[prefixLen]
+		} else {
+			e = io.EOF
+		}
+		return data, e
+	case s.ChunkSize + 1:
+		data.Content = payload[:s.ChunkSize]
+	default:
+		data.Content = payload[:n]
+		data.FinalBlock = data.Name[prefixLen]
+	}
+	return data, nil
 }
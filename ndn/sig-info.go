@@ -74,9 +74,14 @@ func (kl KeyLocator) String() string {
 type SigInfo struct {
 	Type       uint32
 	KeyLocator KeyLocator
-	Nonce      []byte
-	Time       uint64
-	SeqNum     uint64
+
+	// Nonce is the SigNonce field of Signed Interest.
+	Nonce []byte
+	// Time is the SigTime field of Signed Interest, in milliseconds since Unix epoch; zero means absent.
+	Time uint64
+	// SeqNum is the SigSeqNum field of Signed Interest; zero means absent.
+	SeqNum uint64
+
 	Extensions []tlv.Element
 }
 
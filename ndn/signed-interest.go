@@ -0,0 +1,220 @@
+package ndn
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+)
+
+// InterestSignerOptions contains arguments to NewInterestSigner function.
+type InterestSignerOptions struct {
+	// Nonce enables SigNonce field with random octets.
+	Nonce bool
+
+	// NonceLen is the SigNonce length.
+	// Default is 8.
+	NonceLen int
+
+	// Time enables SigTime field.
+	// It is the current time in milliseconds, but strictly increasing among Interests signed by the same signer.
+	Time bool
+
+	// SeqNum enables SigSeqNum field.
+	// It starts from 1 and increments for each Interest signed by the same signer.
+	SeqNum bool
+}
+
+type interestSigner struct {
+	InterestSignerOptions
+	inner    Signer
+	mutex    sync.Mutex
+	lastTime uint64
+	seqNum   uint64
+}
+
+func (s *interestSigner) Sign(packet Signable) error {
+	interest, ok := packet.(*Interest)
+	if !ok {
+		return s.inner.Sign(packet)
+	}
+
+	if interest.SigInfo == nil {
+		interest.SigInfo = newNullSigInfo()
+	}
+	si := interest.SigInfo
+
+	if s.Nonce {
+		si.Nonce = make([]byte, s.NonceLen)
+		rand.Read(si.Nonce)
+	}
+
+	s.mutex.Lock()
+	if s.Time {
+		now := uint64(time.Now().UnixMilli())
+		if now <= s.lastTime {
+			now = s.lastTime + 1
+		}
+		si.Time, s.lastTime = now, now
+	}
+	if s.SeqNum {
+		s.seqNum++
+		si.SeqNum = s.seqNum
+	}
+	s.mutex.Unlock()
+
+	return s.inner.Sign(interest)
+}
+
+// NewInterestSigner creates a Signer that fills Signed Interest fields before signing.
+// Data packets are passed to the inner signer unchanged.
+// https://docs.named-data.net/NDN-packet-spec/0.3/signed-interest.html
+func NewInterestSigner(inner Signer, opts InterestSignerOptions) Signer {
+	if opts.NonceLen <= 0 {
+		opts.NonceLen = 8
+	}
+	return &interestSigner{
+		InterestSignerOptions: opts,
+		inner:                 inner,
+	}
+}
+
+// ReplayCheckerOptions contains arguments to NewReplayChecker function.
+type ReplayCheckerOptions struct {
+	// Nonce requires SigNonce field, and rejects a SigNonce that was seen recently from the same key.
+	Nonce bool
+
+	// NonceCapacity is the number of recent SigNonce values remembered.
+	// Default is 1000.
+	NonceCapacity int
+
+	// Time requires SigTime field within TimeGrace of current time,
+	// and greater than the last SigTime from the same key.
+	Time bool
+
+	// TimeGrace is the maximum difference between SigTime and current time.
+	// Default is 60 seconds.
+	TimeGrace time.Duration
+
+	// SeqNum requires SigSeqNum field greater than the last SigSeqNum from the same key.
+	SeqNum bool
+
+	// MaxKeys is the number of keys whose last SigTime and SigSeqNum are remembered.
+	// Default is 1000.
+	MaxKeys int
+}
+
+func (opts *ReplayCheckerOptions) applyDefaults() {
+	if opts.NonceCapacity <= 0 {
+		opts.NonceCapacity = 1000
+	}
+	if opts.TimeGrace <= 0 {
+		opts.TimeGrace = 60 * time.Second
+	}
+	if opts.MaxKeys <= 0 {
+		opts.MaxKeys = 1000
+	}
+}
+
+type replayKeyState struct {
+	lastTime   uint64
+	lastSeqNum uint64
+}
+
+// ReplayChecker rejects Signed Interests that are replayed.
+// https://docs.named-data.net/NDN-packet-spec/0.3/signed-interest.html
+type ReplayChecker struct {
+	opts  ReplayCheckerOptions
+	mutex sync.Mutex
+
+	keys     map[string]*replayKeyState
+	keyOrder []string
+
+	nonces     map[string]bool
+	nonceOrder []string
+}
+
+// NewReplayChecker creates a ReplayChecker.
+func NewReplayChecker(opts ReplayCheckerOptions) *ReplayChecker {
+	opts.applyDefaults()
+	return &ReplayChecker{
+		opts:   opts,
+		keys:   map[string]*replayKeyState{},
+		nonces: map[string]bool{},
+	}
+}
+
+// Check determines whether a Signed Interest is acceptable, and records its fields if so.
+// This should be invoked after the signature has been verified.
+func (rc *ReplayChecker) Check(interest Interest) error {
+	si := interest.SigInfo
+	if si == nil {
+		si = newNullSigInfo()
+	}
+	keyID := si.KeyLocator.String()
+	nonceID := keyID + "\x00" + string(si.Nonce)
+
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	state := rc.keys[keyID]
+	if state == nil {
+		state = &replayKeyState{}
+	}
+
+	if rc.opts.Nonce && (len(si.Nonce) == 0 || rc.nonces[nonceID]) {
+		return ErrSigNonce
+	}
+	if rc.opts.Time {
+		t := time.UnixMilli(int64(si.Time))
+		if si.Time == 0 || si.Time <= state.lastTime ||
+			t.Before(time.Now().Add(-rc.opts.TimeGrace)) || t.After(time.Now().Add(rc.opts.TimeGrace)) {
+			return ErrSigTime
+		}
+	}
+	if rc.opts.SeqNum && (si.SeqNum == 0 || si.SeqNum <= state.lastSeqNum) {
+		return ErrSigSeqNum
+	}
+
+	if rc.opts.Nonce {
+		rc.nonces[nonceID] = true
+		if rc.nonceOrder = append(rc.nonceOrder, nonceID); len(rc.nonceOrder) > rc.opts.NonceCapacity {
+			delete(rc.nonces, rc.nonceOrder[0])
+			rc.nonceOrder = rc.nonceOrder[1:]
+		}
+	}
+	if rc.opts.Time || rc.opts.SeqNum {
+		if rc.keys[keyID] == nil {
+			rc.keys[keyID] = state
+			if rc.keyOrder = append(rc.keyOrder, keyID); len(rc.keyOrder) > rc.opts.MaxKeys {
+				delete(rc.keys, rc.keyOrder[0])
+				rc.keyOrder = rc.keyOrder[1:]
+			}
+		}
+		state.lastTime, state.lastSeqNum = si.Time, si.SeqNum
+	}
+	return nil
+}
+
+// Wrap creates a Verifier that verifies the signature with inner verifier, and then checks Interests with Check.
+// Data packets are verified with inner verifier only.
+func (rc *ReplayChecker) Wrap(inner Verifier) Verifier {
+	return replayVerifier{rc, inner}
+}
+
+type replayVerifier struct {
+	rc    *ReplayChecker
+	inner Verifier
+}
+
+func (v replayVerifier) Verify(packet Verifiable) error {
+	if e := v.inner.Verify(packet); e != nil {
+		return e
+	}
+	switch interest := packet.(type) {
+	case Interest:
+		return v.rc.Check(interest)
+	case *Interest:
+		return v.rc.Check(*interest)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package ndn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+func TestInterestSigner(t *testing.T) {
+	assert, require := makeAR(t)
+
+	signer := ndn.NewInterestSigner(ndn.DigestSigning, ndn.InterestSignerOptions{
+		Nonce:    true,
+		NonceLen: 16,
+		Time:     true,
+		SeqNum:   true,
+	})
+
+	var lastTime uint64
+	for i := 1; i <= 3; i++ {
+		interest := ndn.MakeInterest("/A", []byte{0xC0})
+		require.NoError(signer.Sign(&interest))
+		assert.NoError(ndn.DigestSigning.Verify(interest))
+
+		wire, e := tlv.EncodeFrom(interest)
+		require.NoError(e)
+		var pkt ndn.Packet
+		require.NoError(tlv.Decode(wire, &pkt))
+		require.NotNil(pkt.Interest)
+		si := pkt.Interest.SigInfo
+		require.NotNil(si)
+
+		assert.Len(si.Nonce, 16)
+		assert.Greater(si.Time, lastTime)
+		assert.InDelta(time.Now().UnixMilli(), si.Time, 5000)
+		assert.EqualValues(i, si.SeqNum)
+		assert.NoError(ndn.DigestSigning.Verify(*pkt.Interest))
+		lastTime = si.Time
+	}
+
+	data := ndn.MakeData("/D")
+	require.NoError(signer.Sign(&data))
+	assert.NoError(ndn.DigestSigning.Verify(data))
+}
+
+func TestReplayChecker(t *testing.T) {
+	assert, require := makeAR(t)
+
+	makeSigned := func(nonce []byte, sigTime time.Time, seqNum uint64) ndn.Interest {
+		interest := ndn.MakeInterest("/A", []byte{0xC0})
+		interest.SigInfo = &ndn.SigInfo{
+			Nonce:  nonce,
+			Time:   uint64(sigTime.UnixMilli()),
+			SeqNum: seqNum,
+		}
+		require.NoError(ndn.DigestSigning.Sign(&interest))
+		return interest
+	}
+
+	now := time.Now()
+	rc := ndn.NewReplayChecker(ndn.ReplayCheckerOptions{
+		Nonce:         true,
+		NonceCapacity: 2,
+		Time:          true,
+		TimeGrace:     10 * time.Second,
+		SeqNum:        true,
+	})
+	verifier := rc.Wrap(ndn.DigestSigning)
+
+	assert.NoError(verifier.Verify(makeSigned([]byte{0x01}, now, 1)))
+	assert.ErrorIs(verifier.Verify(makeSigned([]byte{0x01}, now.Add(time.Millisecond), 2)), ndn.ErrSigNonce)
+	assert.ErrorIs(verifier.Verify(makeSigned(nil, now.Add(time.Millisecond), 2)), ndn.ErrSigNonce)
+	assert.ErrorIs(verifier.Verify(makeSigned([]byte{0x02}, now, 2)), ndn.ErrSigTime)
+	assert.ErrorIs(verifier.Verify(makeSigned([]byte{0x02}, now.Add(-time.Minute), 2)), ndn.ErrSigTime)
+	assert.ErrorIs(verifier.Verify(makeSigned([]byte{0x02}, now.Add(time.Minute), 2)), ndn.ErrSigTime)
+	assert.ErrorIs(verifier.Verify(makeSigned([]byte{0x02}, now.Add(time.Millisecond), 1)), ndn.ErrSigSeqNum)
+	assert.NoError(verifier.Verify(makeSigned([]byte{0x02}, now.Add(time.Millisecond), 2)))
+	assert.NoError(verifier.Verify(makeSigned([]byte{0x03}, now.Add(2*time.Millisecond), 3)))
+
+	// nonce 0x01 has been evicted from the cache
+	assert.NoError(verifier.Verify(makeSigned([]byte{0x01}, now.Add(3*time.Millisecond), 4)))
+
+	forged := makeSigned([]byte{0x04}, now.Add(4*time.Millisecond), 5)
+	forged.SigValue[0] ^= 0xFF
+	assert.ErrorIs(verifier.Verify(forged), ndn.ErrSigValue)
+	assert.NoError(verifier.Verify(makeSigned([]byte{0x04}, now.Add(4*time.Millisecond), 5)))
+
+	signer := ndn.NewInterestSigner(ndn.DigestSigning, ndn.InterestSignerOptions{Nonce: true, Time: true})
+	rc = ndn.NewReplayChecker(ndn.ReplayCheckerOptions{Nonce: true, Time: true})
+	for i := 0; i < 10; i++ {
+		interest := ndn.MakeInterest("/A", []byte{0xC0})
+		require.NoError(signer.Sign(&interest))
+		assert.NoError(rc.Check(interest))
+		assert.Error(rc.Check(interest))
+	}
+}
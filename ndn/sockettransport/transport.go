@@ -29,6 +29,11 @@ type Config struct {
 	// The default is 60s.
 	// The minimum is RedialBackoffInitial.
 	RedialBackoffMaximum time.Duration
+
+	// DisableRedial disables redialing.
+	// If a socket error occurs, the transport enters "down" state and stays there until closed.
+	// This is suitable for a socket accepted from a listener, which cannot be redialed.
+	DisableRedial bool
 }
 
 func (cfg *Config) applyDefaults() {
@@ -57,7 +62,8 @@ func (cnt Counters) String() string {
 // Transport is an l3.Transport that communicates over a socket.
 //
 // A transport has automatic error handling: if a socket error occurs, the transport automatically
-// redials the socket. In case the socket cannot be redialed, the transport remains in "down" status.
+// redials the socket. In case the socket cannot be redialed or redialing is disabled, the transport
+// remains in "down" status.
 type Transport interface {
 	l3.Transport
 
@@ -100,9 +106,10 @@ func New(conn net.Conn, cfg Config) (Transport, error) {
 	cfg.applyDefaults()
 
 	tr := &transport{
-		impl:    impl,
-		conn:    conn,
-		backoff: retry.WithCappedDuration(cfg.RedialBackoffMaximum, retry.NewExponential(cfg.RedialBackoffInitial)),
+		impl:          impl,
+		conn:          conn,
+		backoff:       retry.WithCappedDuration(cfg.RedialBackoffMaximum, retry.NewExponential(cfg.RedialBackoffInitial)),
+		disableRedial: cfg.DisableRedial,
 	}
 	tr.TransportBase, tr.p = l3.NewTransportBase(l3.TransportBaseConfig{
 		MTU: cfg.MTU,
@@ -113,15 +120,16 @@ func New(conn net.Conn, cfg Config) (Transport, error) {
 
 type transport struct {
 	*l3.TransportBase
-	p          *l3.TransportBasePriv
-	impl       impl
-	nRedials   atomic.Int32
-	redialLock sync.Mutex
-	conn       net.Conn
-	rxBuffer   any
-	backoff    retry.Backoff
-	ctx        context.Context
-	cancel     context.CancelFunc
+	p             *l3.TransportBasePriv
+	impl          impl
+	nRedials      atomic.Int32
+	redialLock    sync.Mutex
+	conn          net.Conn
+	rxBuffer      any
+	backoff       retry.Backoff
+	disableRedial bool
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
 func (tr *transport) Context() context.Context {
@@ -166,6 +174,11 @@ func (tr *transport) doRW(f func() (n int, e error)) (n int, e error) {
 		return n, e
 	}
 
+	if tr.disableRedial {
+		tr.p.SetState(l3.TransportDown)
+		return 0, e
+	}
+
 	tr.redialLock.Lock()
 	defer tr.redialLock.Unlock()
 	if !tr.nRedials.CompareAndSwap(nRedialsEnter, nRedialsEnter+1) { // another goroutine performed redial
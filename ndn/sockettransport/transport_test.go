@@ -2,10 +2,14 @@ package sockettransport_test
 
 import (
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/usnistgov/ndn-dpdk/ndn/ndntestenv"
 	"github.com/usnistgov/ndn-dpdk/ndn/sockettransport"
 )
@@ -20,6 +24,16 @@ func TestPipe(t *testing.T) {
 	c.CheckTransport(t, trA, trB)
 }
 
+func TestPipeFragmentation(t *testing.T) {
+	_, require := makeAR(t)
+
+	trA, trB, e := sockettransport.Pipe(sockettransport.Config{MTU: 1200})
+	require.NoError(e)
+
+	c := ndntestenv.L3FaceTester{DataPayloadLen: 3000}
+	c.CheckTransport(t, trA, trB)
+}
+
 func TestUDP(t *testing.T) {
 	assert, require := makeAR(t)
 
@@ -59,6 +73,29 @@ func TestUnix(t *testing.T) {
 	checkStream(t, listener)
 }
 
+func TestWebSocket(t *testing.T) {
+	assert, require := makeAR(t)
+
+	trBC := make(chan sockettransport.Transport, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{}
+		conn, e := upgrader.Upgrade(w, r, nil)
+		require.NoError(e)
+		tr, e := sockettransport.New(sockettransport.NewWebSocketConn(conn, ""), sockettransport.Config{})
+		require.NoError(e)
+		trBC <- tr
+	}))
+	defer server.Close()
+
+	trA, e := sockettransport.Dial("ws", "", "ws"+strings.TrimPrefix(server.URL, "http")+"/", sockettransport.Config{})
+	require.NoError(e)
+	assert.Equal("ws", trA.Conn().RemoteAddr().Network())
+	trB := <-trBC
+
+	var c ndntestenv.L3FaceTester
+	c.CheckTransport(t, trA, trB)
+}
+
 func checkStream(t testing.TB, listener net.Listener) {
 	_, require := makeAR(t)
 
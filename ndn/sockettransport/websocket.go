@@ -0,0 +1,124 @@
+package sockettransport
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const schemeWebSocket = "ws"
+
+// WebSocketAddr is the address of a WebSocket endpoint.
+type WebSocketAddr string
+
+var _ net.Addr = WebSocketAddr("")
+
+// Network returns "ws".
+func (WebSocketAddr) Network() string {
+	return schemeWebSocket
+}
+
+func (addr WebSocketAddr) String() string {
+	return string(addr)
+}
+
+// webSocketConn adapts a WebSocket connection to net.Conn.
+// Each WebSocket binary message carries one NDN packet, consistent with NFD WebSocket channel.
+type webSocketConn struct {
+	conn       *websocket.Conn
+	localAddr  net.Addr
+	remoteAddr net.Addr
+	wMutex     sync.Mutex
+}
+
+var _ net.Conn = (*webSocketConn)(nil)
+
+// NewWebSocketConn wraps a WebSocket connection as net.Conn.
+// Read returns one binary message at a time; other messages and oversized messages are skipped.
+// Write sends one binary message.
+//
+// Its LocalAddr and RemoteAddr have network "ws", so that New creates a transport for it.
+// If remoteURL is non-empty, it is used as the remote address, and the transport can redial it.
+func NewWebSocketConn(conn *websocket.Conn, remoteURL string) net.Conn {
+	c := &webSocketConn{
+		conn:       conn,
+		localAddr:  WebSocketAddr(conn.LocalAddr().String()),
+		remoteAddr: WebSocketAddr(conn.RemoteAddr().String()),
+	}
+	if remoteURL != "" {
+		c.remoteAddr = WebSocketAddr(remoteURL)
+	}
+	return c
+}
+
+func (c *webSocketConn) Read(buf []byte) (n int, e error) {
+	for {
+		mt, msg, e := c.conn.ReadMessage()
+		if e != nil {
+			return 0, e
+		}
+		if mt == websocket.BinaryMessage && len(msg) <= len(buf) {
+			return copy(buf, msg), nil
+		}
+	}
+}
+
+func (c *webSocketConn) Write(buf []byte) (n int, e error) {
+	c.wMutex.Lock()
+	defer c.wMutex.Unlock()
+	if e := c.conn.WriteMessage(websocket.BinaryMessage, buf); e != nil {
+		return 0, e
+	}
+	return len(buf), nil
+}
+
+func (c *webSocketConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *webSocketConn) LocalAddr() net.Addr {
+	return c.localAddr
+}
+
+func (c *webSocketConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *webSocketConn) SetDeadline(t time.Time) error {
+	c.conn.SetReadDeadline(t)
+	return c.conn.SetWriteDeadline(t)
+}
+
+func (c *webSocketConn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+func (c *webSocketConn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
+
+type webSocketImpl struct {
+	datagramImpl
+}
+
+// Dial connects to a WebSocket server.
+// remote is a URL such as "ws://127.0.0.1:9696/"; local is ignored.
+func (webSocketImpl) Dial(network, local, remote string) (net.Conn, error) {
+	conn, _, e := websocket.DefaultDialer.Dial(remote, nil)
+	if e != nil {
+		return nil, e
+	}
+	return NewWebSocketConn(conn, remote), nil
+}
+
+func (impl webSocketImpl) Redial(oldConn net.Conn) (net.Conn, error) {
+	remote := oldConn.RemoteAddr()
+	oldConn.Close() // ignore error
+	return impl.Dial(remote.Network(), "", remote.String())
+}
+
+func init() {
+	implByNetwork[schemeWebSocket] = webSocketImpl{}
+}
@@ -0,0 +1,28 @@
+package svs
+
+import (
+	"context"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+)
+
+// Fetch retrieves missing publications in sequence number order.
+// Each retrieved Data is passed to the callback.
+// It stops at the first error, which could be a retrieval error or an error returned by the callback.
+// If the range is longer than MaxMissingPerNode, only the latest sequence numbers are retrieved.
+func Fetch(ctx context.Context, missing MissingData, opts endpoint.ConsumerOptions, cb func(seq uint64, data *ndn.Data) error) error {
+	n, low := missing.Len(), missing.clamp().Low
+	for i := 0; i < n; i++ {
+		seq := low + uint64(i)
+		interest := ndn.MakeInterest(MakeDataName(missing.Node, seq))
+		data, e := endpoint.Consume(ctx, interest, opts)
+		if e != nil {
+			return e
+		}
+		if e = cb(seq, data); e != nil {
+			return e
+		}
+	}
+	return nil
+}
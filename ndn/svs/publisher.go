@@ -0,0 +1,91 @@
+package svs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// PublisherOptions contains options for NewPublisher function.
+type PublisherOptions struct {
+	// ProducerOptions includes L3 forwarder, signer, etc.
+	// Prefix and Handler will be overwritten.
+	endpoint.ProducerOptions
+
+	// Freshness is Data packet FreshnessPeriod.
+	// Default is zero.
+	Freshness time.Duration
+}
+
+// Publisher publishes Data under /<node>/<seq> and announces them via Sync.
+type Publisher struct {
+	sync     *Sync
+	producer endpoint.Producer
+	mutex    sync.RWMutex
+	store    map[uint64]ndn.Data
+	tpl      ndn.Data
+}
+
+// NewPublisher creates a Publisher.
+// The Sync participant must not be incremented by other means.
+func NewPublisher(ctx context.Context, s *Sync, opts PublisherOptions) (p *Publisher, e error) {
+	p = &Publisher{
+		sync:  s,
+		store: map[uint64]ndn.Data{},
+		tpl: ndn.Data{
+			Freshness: opts.Freshness,
+		},
+	}
+	opts.Prefix = s.NodeID()
+	opts.Handler = p.handle
+	if p.producer, e = endpoint.Produce(ctx, opts.ProducerOptions); e != nil {
+		return nil, e
+	}
+	return p, nil
+}
+
+// Publish publishes a Data packet with the next sequence number.
+// Returns the Data name.
+func (p *Publisher) Publish(content []byte) (name ndn.Name) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	seq := p.sync.SeqNo() + 1
+	data := p.tpl
+	data.Name = MakeDataName(p.sync.NodeID(), seq)
+	data.Content = content
+	p.store[seq] = data
+
+	p.sync.IncrementSeqNo()
+	return data.Name
+}
+
+// Close stops the producer.
+func (p *Publisher) Close() error {
+	return p.producer.Close()
+}
+
+func (p *Publisher) handle(_ context.Context, interest ndn.Interest) (ndn.Data, error) {
+	comp := interest.Name.Get(len(p.sync.NodeID()))
+	if len(interest.Name) != len(p.sync.NodeID())+1 || comp.Type != an.TtSequenceNumNameComponent {
+		return ndn.Data{}, errors.New("sequence number component not found")
+	}
+	var seq tlv.NNI
+	if e := seq.UnmarshalBinary(comp.Value); e != nil {
+		return ndn.Data{}, e
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	data, ok := p.store[uint64(seq)]
+	if !ok {
+		return ndn.Data{}, endpoint.ReplyNack(an.NackNoRoute)
+	}
+	return data, nil
+}
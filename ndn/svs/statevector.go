@@ -0,0 +1,214 @@
+// Package svs implements State Vector Sync (SVS) protocol.
+// https://named-data.github.io/StateVectorSync/Specification.html
+//
+// Each node publishes Data under /<node>/<seq>, where seq is a SequenceNum component.
+// Nodes exchange their knowledge of the latest sequence numbers via Sync Interests, which carry an encoded state vector.
+package svs
+
+import (
+	"errors"
+	"math"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/an"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+	"golang.org/x/exp/slices"
+)
+
+// ErrStateVector indicates a malformed state vector.
+var ErrStateVector = errors.New("bad StateVector")
+
+// TLV-TYPE assigned numbers.
+const (
+	TtStateVector      = 0xC9
+	TtStateVectorEntry = 0xCA
+	TtSeqNo            = 0xCC
+)
+
+// MaxSeqNo is the maximum sequence number accepted in a state vector.
+// It is less than math.MaxUint64, so that incrementing a sequence number cannot overflow.
+const MaxSeqNo uint64 = math.MaxUint64 - 1
+
+// MaxMissingPerNode is the maximum number of sequence numbers enumerated in a MissingData range.
+// If a node advances further, only its latest sequence numbers are enumerated.
+const MaxMissingPerNode = 4096
+
+// MakeDataName returns the name of a publication.
+func MakeDataName(node ndn.Name, seq uint64) ndn.Name {
+	return node.Append(ndn.NameComponentFrom(an.TtSequenceNumNameComponent, tlv.NNI(seq)))
+}
+
+// StateVectorEntry is a node name and its latest sequence number.
+type StateVectorEntry struct {
+	Node  ndn.Name
+	SeqNo uint64
+}
+
+// StateVector maps node names to their latest sequence numbers.
+// The zero value is an empty state vector.
+type StateVector struct {
+	m map[string]StateVectorEntry
+}
+
+var (
+	_ tlv.Fielder     = StateVector{}
+	_ tlv.Unmarshaler = (*StateVector)(nil)
+)
+
+func nameKey(name ndn.Name) string {
+	nameV, _ := name.MarshalBinary()
+	return string(nameV)
+}
+
+// Get returns the sequence number of a node.
+// Returns zero if the node is unknown.
+func (sv StateVector) Get(node ndn.Name) uint64 {
+	return sv.m[nameKey(node)].SeqNo
+}
+
+// Set assigns the sequence number of a node.
+func (sv *StateVector) Set(node ndn.Name, seq uint64) {
+	if sv.m == nil {
+		sv.m = map[string]StateVectorEntry{}
+	}
+	sv.m[nameKey(node)] = StateVectorEntry{Node: node, SeqNo: seq}
+}
+
+// Len returns the number of nodes.
+func (sv StateVector) Len() int {
+	return len(sv.m)
+}
+
+// Entries returns a list of entries, sorted by node name.
+func (sv StateVector) Entries() (list []StateVectorEntry) {
+	for _, entry := range sv.m {
+		list = append(list, entry)
+	}
+	slices.SortFunc(list, func(a, b StateVectorEntry) bool { return a.Node.Compare(b.Node) < 0 })
+	return list
+}
+
+// Clone returns a copy of the state vector.
+func (sv StateVector) Clone() (copy StateVector) {
+	for _, entry := range sv.m {
+		copy.Set(entry.Node, entry.SeqNo)
+	}
+	return copy
+}
+
+// Merge updates this state vector with newer sequence numbers from other.
+// Returns the ranges of sequence numbers that are new to this state vector.
+// Each range contains at most MaxMissingPerNode latest sequence numbers.
+func (sv *StateVector) Merge(other StateVector) (missing []MissingData) {
+	for _, entry := range other.Entries() {
+		if seq := sv.Get(entry.Node); entry.SeqNo > seq {
+			missing = append(missing, MissingData{Node: entry.Node, Low: seq + 1, High: entry.SeqNo}.clamp())
+			sv.Set(entry.Node, entry.SeqNo)
+		}
+	}
+	return missing
+}
+
+// IsNewerThan determines whether this state vector contains any sequence number newer than other.
+func (sv StateVector) IsNewerThan(other StateVector) bool {
+	for _, entry := range sv.m {
+		if entry.SeqNo > other.Get(entry.Node) {
+			return true
+		}
+	}
+	return false
+}
+
+// Field implements tlv.Fielder interface.
+func (sv StateVector) Field() tlv.Field {
+	var fields []tlv.Fielder
+	for _, entry := range sv.Entries() {
+		fields = append(fields, tlv.TLVFrom(TtStateVectorEntry, entry.Node, tlv.TLVNNI(TtSeqNo, entry.SeqNo)))
+	}
+	return tlv.TLVFrom(TtStateVector, fields...)
+}
+
+// UnmarshalTLV implements tlv.Unmarshaler interface.
+func (sv *StateVector) UnmarshalTLV(typ uint32, value []byte) (e error) {
+	if typ != TtStateVector {
+		return tlv.ErrType
+	}
+
+	*sv = StateVector{}
+	d := tlv.DecodingBuffer(value)
+	for _, de := range d.Elements() {
+		if de.Type != TtStateVectorEntry {
+			if de.IsCriticalType() {
+				return tlv.ErrCritical
+			}
+			continue
+		}
+
+		var entry StateVectorEntry
+		hasName, hasSeqNo := false, false
+		d1 := tlv.DecodingBuffer(de.Value)
+		for _, de1 := range d1.Elements() {
+			switch de1.Type {
+			case an.TtName:
+				if e = de1.UnmarshalValue(&entry.Node); e != nil {
+					return e
+				}
+				hasName = true
+			case TtSeqNo:
+				entry.SeqNo = de1.UnmarshalNNI(MaxSeqNo, &e, tlv.ErrRange)
+				if e != nil {
+					return e
+				}
+				hasSeqNo = true
+			default:
+				if de1.IsCriticalType() {
+					return tlv.ErrCritical
+				}
+			}
+		}
+		if e = d1.ErrUnlessEOF(); e != nil {
+			return e
+		}
+		if !hasName || !hasSeqNo {
+			return ErrStateVector
+		}
+		sv.Set(entry.Node, entry.SeqNo)
+	}
+	return d.ErrUnlessEOF()
+}
+
+// MissingData is a range of sequence numbers that are not yet retrieved.
+// Low and High are inclusive.
+type MissingData struct {
+	Node ndn.Name
+	Low  uint64
+	High uint64
+}
+
+// clamp restricts the range to the latest MaxMissingPerNode sequence numbers.
+func (m MissingData) clamp() MissingData {
+	if m.High-m.Low >= MaxMissingPerNode && m.Low <= m.High {
+		m.Low = m.High - MaxMissingPerNode + 1
+	}
+	return m
+}
+
+// Len returns the number of sequence numbers enumerated by Names and Fetch.
+// This is at most MaxMissingPerNode.
+func (m MissingData) Len() int {
+	if m.Low > m.High {
+		return 0
+	}
+	m = m.clamp()
+	return int(m.High-m.Low) + 1
+}
+
+// Names returns the names of missing publications.
+// If the range is longer than MaxMissingPerNode, only the latest sequence numbers are included.
+func (m MissingData) Names() (names []ndn.Name) {
+	n, low := m.Len(), m.clamp().Low
+	for i := 0; i < n; i++ {
+		names = append(names, MakeDataName(m.Node, low+uint64(i)))
+	}
+	return names
+}
@@ -0,0 +1,175 @@
+package svs_test
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/core/testenv"
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/ndntestenv"
+	"github.com/usnistgov/ndn-dpdk/ndn/svs"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+	"go4.org/must"
+)
+
+var (
+	makeAR    = testenv.MakeAR
+	nameEqual = ndntestenv.NameEqual
+)
+
+func TestStateVector(t *testing.T) {
+	assert, require := makeAR(t)
+
+	var sv svs.StateVector
+	sv.Set(ndn.ParseName("/B"), 5)
+	sv.Set(ndn.ParseName("/A"), 3)
+	assert.Equal(2, sv.Len())
+	assert.EqualValues(3, sv.Get(ndn.ParseName("/A")))
+	assert.EqualValues(0, sv.Get(ndn.ParseName("/C")))
+
+	wire, e := tlv.EncodeFrom(sv)
+	require.NoError(e)
+	assert.Equal([]byte{
+		0xC9, 0x14,
+		0xCA, 0x08, 0x07, 0x03, 0x08, 0x01, 0x41, 0xCC, 0x01, 0x03,
+		0xCA, 0x08, 0x07, 0x03, 0x08, 0x01, 0x42, 0xCC, 0x01, 0x05,
+	}, wire)
+
+	var decoded svs.StateVector
+	require.NoError(tlv.Decode(wire, &decoded))
+	entries := decoded.Entries()
+	require.Len(entries, 2)
+	nameEqual(assert, "/A", entries[0].Node)
+	assert.EqualValues(3, entries[0].SeqNo)
+	nameEqual(assert, "/B", entries[1].Node)
+	assert.EqualValues(5, entries[1].SeqNo)
+
+	assert.Error(tlv.Decode([]byte{0xC9, 0x05, 0xCA, 0x03, 0xCC, 0x01, 0x01}, &decoded))
+
+	var other svs.StateVector
+	other.Set(ndn.ParseName("/A"), 7)
+	other.Set(ndn.ParseName("/B"), 2)
+	other.Set(ndn.ParseName("/C"), 1)
+	assert.True(other.IsNewerThan(sv))
+	assert.True(sv.IsNewerThan(other))
+
+	missing := sv.Merge(other)
+	require.Len(missing, 2)
+	nameEqual(assert, "/A", missing[0].Node)
+	assert.EqualValues(4, missing[0].Low)
+	assert.EqualValues(7, missing[0].High)
+	nameEqual(assert, "/C", missing[1].Node)
+	assert.EqualValues(1, missing[1].Low)
+	assert.EqualValues(1, missing[1].High)
+	assert.Len(missing[0].Names(), 4)
+	assert.EqualValues(5, sv.Get(ndn.ParseName("/B")))
+	assert.False(other.IsNewerThan(sv))
+}
+
+type syncNode struct {
+	*svs.Sync
+	pub *svs.Publisher
+
+	mutex    sync.Mutex
+	received map[string][]string
+}
+
+func TestStateVectorBounds(t *testing.T) {
+	assert, require := makeAR(t)
+
+	var decoded svs.StateVector
+	assert.ErrorIs(tlv.Decode([]byte{
+		0xC9, 0x11,
+		0xCA, 0x0F, 0x07, 0x03, 0x08, 0x01, 0x41, 0xCC, 0x08, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	}, &decoded), tlv.ErrRange)
+
+	var sv, remote svs.StateVector
+	sv.Set(ndn.ParseName("/A"), 10)
+	remote.Set(ndn.ParseName("/A"), svs.MaxSeqNo)
+	missing := sv.Merge(remote)
+	require.Len(missing, 1)
+	assert.EqualValues(svs.MaxSeqNo-svs.MaxMissingPerNode+1, missing[0].Low)
+	assert.EqualValues(svs.MaxSeqNo, missing[0].High)
+	assert.Equal(svs.MaxMissingPerNode, missing[0].Len())
+
+	m := svs.MissingData{Node: ndn.ParseName("/A"), Low: 0, High: math.MaxUint64}
+	names := m.Names()
+	require.Len(names, svs.MaxMissingPerNode)
+	nameEqual(assert, svs.MakeDataName(m.Node, math.MaxUint64), names[len(names)-1])
+
+	assert.Equal(0, svs.MissingData{Low: 5, High: 4}.Len())
+	assert.Len(svs.MissingData{Low: 5, High: 4}.Names(), 0)
+}
+
+func TestSync(t *testing.T) {
+	assert, require := makeAR(t)
+	fw := l3.NewForwarder()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	newNode := func(id string) *syncNode {
+		node := &syncNode{received: map[string][]string{}}
+		var e error
+		node.Sync, e = svs.NewSync(svs.SyncOptions{
+			Fw:              fw,
+			GroupPrefix:     ndn.ParseName("/G"),
+			NodeID:          ndn.ParseName(id),
+			PeriodicTimeout: 500 * time.Millisecond,
+			OnUpdate: func(missing []svs.MissingData) {
+				for _, m := range missing {
+					m := m
+					go svs.Fetch(ctx, m, endpoint.ConsumerOptions{Fw: fw}, func(seq uint64, data *ndn.Data) error {
+						node.mutex.Lock()
+						defer node.mutex.Unlock()
+						key := m.Node.String()
+						node.received[key] = append(node.received[key], string(data.Content))
+						return nil
+					})
+				}
+			},
+		})
+		require.NoError(e)
+		node.pub, e = svs.NewPublisher(ctx, node.Sync, svs.PublisherOptions{
+			ProducerOptions: endpoint.ProducerOptions{Fw: fw, DataSigner: ndn.DigestSigning},
+		})
+		require.NoError(e)
+		return node
+	}
+	closeNode := func(node *syncNode) {
+		must.Close(node.pub)
+		must.Close(node.Sync)
+	}
+
+	nodeA, nodeB := newNode("/A"), newNode("/B")
+	defer closeNode(nodeA)
+	defer closeNode(nodeB)
+
+	nameEqual(assert, "/A/58=%01", nodeA.pub.Publish([]byte("A1")))
+	nodeA.pub.Publish([]byte("A2"))
+	nodeB.pub.Publish([]byte("B1"))
+	time.Sleep(200 * time.Millisecond)
+
+	// late joiner learns the state from Sync Interests
+	nodeC := newNode("/C")
+	defer closeNode(nodeC)
+	nodeC.pub.Publish([]byte("C1"))
+	time.Sleep(1500 * time.Millisecond)
+
+	for _, node := range []*syncNode{nodeA, nodeB, nodeC} {
+		state := node.State()
+		assert.EqualValues(2, state.Get(ndn.ParseName("/A")))
+		assert.EqualValues(1, state.Get(ndn.ParseName("/B")))
+		assert.EqualValues(1, state.Get(ndn.ParseName("/C")))
+	}
+
+	nodeC.mutex.Lock()
+	defer nodeC.mutex.Unlock()
+	assert.Equal([]string{"A1", "A2"}, nodeC.received["/8=A"])
+	assert.Equal([]string{"B1"}, nodeC.received["/8=B"])
+	assert.NotContains(nodeC.received, "/8=C")
+}
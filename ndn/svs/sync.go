@@ -0,0 +1,242 @@
+package svs
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/tlv"
+)
+
+// SyncOptions contains options for NewSync function.
+type SyncOptions struct {
+	// Fw specifies the L3 Forwarder.
+	// Default is the default Forwarder.
+	Fw l3.Forwarder
+
+	// GroupPrefix is the sync group prefix.
+	// Sync Interests are sent and received under this prefix.
+	GroupPrefix ndn.Name
+
+	// NodeID is the name of this node.
+	// Publications of this node are named NodeID/seq.
+	NodeID ndn.Name
+
+	// PeriodicTimeout is the average interval between periodic Sync Interests.
+	// Actual interval is randomized within ±10%.
+	// Default is 30 seconds.
+	PeriodicTimeout time.Duration
+
+	// SuppressionPeriod is the maximum suppression delay, when an outdated Sync Interest is received.
+	// Default is 200 milliseconds.
+	SuppressionPeriod time.Duration
+
+	// SyncInterestLifetime is the InterestLifetime of Sync Interests.
+	// Default is 1 second.
+	SyncInterestLifetime time.Duration
+
+	// Signer signs outgoing Sync Interests.
+	// Default is no signing.
+	Signer ndn.Signer
+
+	// Verifier verifies incoming Sync Interests.
+	// Default is no verification.
+	Verifier ndn.Verifier
+
+	// OnUpdate is invoked when sequence numbers of other nodes are advanced.
+	// It is called on the sync goroutine, and should return quickly.
+	OnUpdate func(missing []MissingData)
+}
+
+func (opts *SyncOptions) applyDefaults() {
+	if opts.PeriodicTimeout <= 0 {
+		opts.PeriodicTimeout = 30 * time.Second
+	}
+	if opts.SuppressionPeriod <= 0 {
+		opts.SuppressionPeriod = 200 * time.Millisecond
+	}
+	if opts.SyncInterestLifetime <= 0 {
+		opts.SyncInterestLifetime = time.Second
+	}
+	if opts.Verifier == nil {
+		opts.Verifier = ndn.NopVerifier
+	}
+}
+
+// Sync is a State Vector Sync participant.
+type Sync struct {
+	opts    SyncOptions
+	face    *endpoint.LFace
+	mutex   sync.Mutex
+	sv      StateVector
+	publish chan struct{}
+	closing chan struct{}
+	closed  chan struct{}
+}
+
+// NewSync creates a Sync participant and starts synchronization.
+func NewSync(opts SyncOptions) (s *Sync, e error) {
+	opts.applyDefaults()
+	s = &Sync{
+		opts:    opts,
+		publish: make(chan struct{}, 1),
+		closing: make(chan struct{}),
+		closed:  make(chan struct{}),
+	}
+	s.sv.Set(opts.NodeID, 0)
+
+	if s.face, e = endpoint.NewLFace(opts.Fw); e != nil {
+		return nil, e
+	}
+	s.face.FwFace.AddRoute(opts.GroupPrefix)
+	go s.loop()
+	return s, nil
+}
+
+// NodeID returns the name of this node.
+func (s *Sync) NodeID() ndn.Name {
+	return s.opts.NodeID
+}
+
+// SeqNo returns the latest sequence number of this node.
+func (s *Sync) SeqNo() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.sv.Get(s.opts.NodeID)
+}
+
+// IncrementSeqNo increments the sequence number of this node, and triggers a Sync Interest.
+// Returns the new sequence number.
+func (s *Sync) IncrementSeqNo() (seq uint64) {
+	s.mutex.Lock()
+	seq = s.sv.Get(s.opts.NodeID) + 1
+	s.sv.Set(s.opts.NodeID, seq)
+	s.mutex.Unlock()
+
+	select {
+	case s.publish <- struct{}{}:
+	default:
+	}
+	return seq
+}
+
+// State returns a copy of the current state vector.
+func (s *Sync) State() StateVector {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.sv.Clone()
+}
+
+// Close stops synchronization.
+func (s *Sync) Close() error {
+	close(s.closing)
+	<-s.closed
+	return s.face.Close()
+}
+
+func (s *Sync) loop() {
+	defer close(s.closed)
+	periodic := time.NewTimer(s.periodicDelay())
+	defer periodic.Stop()
+	suppression := time.NewTimer(0)
+	defer suppression.Stop()
+	<-suppression.C
+	suppressing, recorded := false, StateVector{}
+
+	resetPeriodic := func() {
+		if !periodic.Stop() {
+			select {
+			case <-periodic.C:
+			default:
+			}
+		}
+		periodic.Reset(s.periodicDelay())
+	}
+
+	s.sendSyncInterest()
+	for {
+		select {
+		case <-s.closing:
+			return
+		case <-periodic.C:
+			s.sendSyncInterest()
+			periodic.Reset(s.periodicDelay())
+		case <-s.publish:
+			s.sendSyncInterest()
+			resetPeriodic()
+		case <-suppression.C:
+			suppressing = false
+			s.mutex.Lock()
+			outdated := s.sv.IsNewerThan(recorded)
+			s.mutex.Unlock()
+			if outdated {
+				s.sendSyncInterest()
+			}
+			resetPeriodic()
+		case l3pkt := <-s.face.Rx():
+			pkt := l3pkt.ToPacket()
+			if pkt.Interest == nil {
+				continue
+			}
+			remote, ok := s.decodeSyncInterest(*pkt.Interest)
+			if !ok {
+				continue
+			}
+
+			s.mutex.Lock()
+			missing := s.sv.Merge(remote)
+			outdated := s.sv.IsNewerThan(remote)
+			s.mutex.Unlock()
+			if len(missing) > 0 && s.opts.OnUpdate != nil {
+				s.opts.OnUpdate(missing)
+			}
+
+			switch {
+			case suppressing:
+				recorded.Merge(remote)
+			case outdated:
+				suppressing = true
+				recorded = remote
+				suppression.Reset(time.Duration(rand.Int63n(int64(s.opts.SuppressionPeriod))))
+			default:
+				resetPeriodic()
+			}
+		}
+	}
+}
+
+func (s *Sync) periodicDelay() time.Duration {
+	return time.Duration(float64(s.opts.PeriodicTimeout) * (0.9 + 0.2*rand.Float64()))
+}
+
+func (s *Sync) sendSyncInterest() {
+	s.mutex.Lock()
+	value, e := tlv.EncodeFrom(s.sv)
+	s.mutex.Unlock()
+	if e != nil {
+		return
+	}
+
+	interest := ndn.MakeInterest(s.opts.GroupPrefix, value, s.opts.SyncInterestLifetime)
+	if s.opts.Signer != nil {
+		if e := s.opts.Signer.Sign(&interest); e != nil {
+			return
+		}
+	} else {
+		interest.UpdateParamsDigest()
+	}
+	s.face.Send(interest.ToPacket())
+}
+
+func (s *Sync) decodeSyncInterest(interest ndn.Interest) (remote StateVector, ok bool) {
+	if !s.opts.GroupPrefix.IsPrefixOf(interest.Name) || s.opts.Verifier.Verify(interest) != nil {
+		return remote, false
+	}
+	if e := tlv.Decode(interest.AppParameters, &remote); e != nil {
+		return remote, false
+	}
+	return remote, true
+}
@@ -0,0 +1,162 @@
+package trustschema
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"golang.org/x/exp/maps"
+)
+
+// ErrPattern indicates a syntax error in name pattern.
+var ErrPattern = errors.New("bad name pattern")
+
+type patternCompKind int
+
+const (
+	patternLiteral patternCompKind = iota
+	patternAny
+	patternVar
+	patternRest
+)
+
+type patternComp struct {
+	kind patternCompKind
+	lit  ndn.NameComponent
+	id   string
+}
+
+func (pc patternComp) String() string {
+	switch pc.kind {
+	case patternAny:
+		return "<>"
+	case patternVar:
+		return "<" + pc.id + ">"
+	case patternRest:
+		return "<**>"
+	}
+	return pc.lit.String()
+}
+
+// Pattern is a name pattern.
+//
+// Its string representation is similar to a name URI, in which each component is either:
+//   - a name component in URI representation, which matches itself.
+//   - "<>", which matches any one component.
+//   - "<id>", which matches any one component and binds it to variable "id".
+//     All occurrences of the same variable in a Rule must match the same component.
+//   - "<**>", which matches zero or more components.
+type Pattern []patternComp
+
+// ParsePattern parses a name pattern from its string representation.
+func ParsePattern(input string) (p Pattern, e error) {
+	for _, token := range strings.Split(input, "/") {
+		switch {
+		case token == "":
+			continue
+		case token == "<>":
+			p = append(p, patternComp{kind: patternAny})
+		case token == "<**>":
+			p = append(p, patternComp{kind: patternRest})
+		case strings.HasPrefix(token, "<") && strings.HasSuffix(token, ">"):
+			id := token[1 : len(token)-1]
+			if strings.ContainsAny(id, "<>*") {
+				return nil, ErrPattern
+			}
+			p = append(p, patternComp{kind: patternVar, id: id})
+		case strings.ContainsAny(token, "<>"):
+			return nil, ErrPattern
+		default:
+			p = append(p, patternComp{kind: patternLiteral, lit: ndn.ParseNameComponent(token)})
+		}
+	}
+	return p, nil
+}
+
+// MustParsePattern parses a name pattern, and panics on error.
+func MustParsePattern(input string) Pattern {
+	p, e := ParsePattern(input)
+	if e != nil {
+		panic(e)
+	}
+	return p
+}
+
+// String returns string representation of the pattern.
+func (p Pattern) String() string {
+	if len(p) == 0 {
+		return "/"
+	}
+	var b strings.Builder
+	for _, pc := range p {
+		b.WriteByte('/')
+		b.WriteString(pc.String())
+	}
+	return b.String()
+}
+
+// MarshalText implements encoding.TextMarshaler interface.
+func (p Pattern) MarshalText() (text []byte, e error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (p *Pattern) UnmarshalText(text []byte) (e error) {
+	*p, e = ParsePattern(string(text))
+	return e
+}
+
+// Vars contains variable bindings.
+type Vars map[string]ndn.NameComponent
+
+// Match determines whether a name matches the pattern.
+// If it matches, returns variable bindings; otherwise, returns nil.
+func (p Pattern) Match(name ndn.Name) Vars {
+	var result Vars
+	p.match(name, Vars{}, func(vars Vars) bool {
+		result = vars
+		return true
+	})
+	return result
+}
+
+// match enumerates all possible variable bindings when name matches the pattern.
+// vars contains existing bindings, which are not modified.
+// yield is invoked for each possible binding; it returns true to stop the enumeration.
+// Returns true if enumeration was stopped by yield.
+func (p Pattern) match(name ndn.Name, vars Vars, yield func(vars Vars) bool) bool {
+	if len(p) == 0 {
+		return len(name) == 0 && yield(vars)
+	}
+
+	pc, rest := p[0], p[1:]
+	if pc.kind == patternRest {
+		for i := 0; i <= len(name); i++ {
+			if rest.match(name[i:], vars, yield) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+	comp := name[0]
+	switch pc.kind {
+	case patternLiteral:
+		if !comp.Equal(pc.lit) {
+			return false
+		}
+	case patternVar:
+		if bound, ok := vars[pc.id]; ok {
+			if !comp.Equal(bound) {
+				return false
+			}
+		} else {
+			vars = maps.Clone(vars)
+			vars[pc.id] = comp
+		}
+	}
+	return rest.match(name[1:], vars, yield)
+}
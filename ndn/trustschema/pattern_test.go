@@ -0,0 +1,51 @@
+package trustschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/trustschema"
+)
+
+func TestPattern(t *testing.T) {
+	assert, require := makeAR(t)
+
+	for _, bad := range []string{"/A/<a", "/A/<<a>>", "/A/<a*>", "/A/b>"} {
+		_, e := trustschema.ParsePattern(bad)
+		assert.ErrorIs(e, trustschema.ErrPattern, bad)
+	}
+
+	p := trustschema.MustParsePattern("/A/<x>/<>/<**>/Z")
+	assert.Equal("/8=A/<x>/<>/<**>/8=Z", p.String())
+
+	assert.Nil(p.Match(ndn.ParseName("/A/B")))
+	assert.Nil(p.Match(ndn.ParseName("/A/B/C/D")))
+	assert.Nil(p.Match(ndn.ParseName("/B/B/C/Z")))
+	vars := p.Match(ndn.ParseName("/A/B/C/Z"))
+	require.NotNil(vars)
+	assert.Equal("8=B", vars["x"].String())
+	vars = p.Match(ndn.ParseName("/A/B/C/D/E/Z"))
+	require.NotNil(vars)
+	assert.Len(vars, 1)
+
+	var schema trustschema.Schema
+	require.NoError(json.Unmarshal([]byte(`{ "rules": [
+		{ "packet": "/<site>/<user>/blog/<**>", "signer": "/<site>/<user>/KEY/<>" },
+		{ "packet": "/<site>/<user>/KEY/<**>", "signer": "/<site>/KEY/<>" }
+	] }`), &schema))
+	require.Len(schema.Rules, 2)
+
+	assert.True(schema.Match(ndn.ParseName("/S/U/blog/1"), ndn.ParseName("/S/U/KEY/k")))
+	assert.True(schema.Match(ndn.ParseName("/S/U/blog"), ndn.ParseName("/S/U/KEY/k")))
+	assert.False(schema.Match(ndn.ParseName("/S/U/blog/1"), ndn.ParseName("/S/V/KEY/k")))
+	assert.False(schema.Match(ndn.ParseName("/S/U/blog/1"), ndn.ParseName("/S/KEY/k")))
+	assert.True(schema.Match(ndn.ParseName("/S/U/KEY/k/self/v=1"), ndn.ParseName("/S/KEY/k")))
+	assert.False(schema.Match(ndn.ParseName("/S/U/KEY/k/self/v=1"), ndn.ParseName("/T/KEY/k")))
+
+	j, e := json.Marshal(schema)
+	require.NoError(e)
+	var decoded trustschema.Schema
+	require.NoError(json.Unmarshal(j, &decoded))
+	assert.Equal(schema, decoded)
+}
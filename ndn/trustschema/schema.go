@@ -0,0 +1,47 @@
+// Package trustschema implements a rule-based trust schema and a validator with certificate chain fetching.
+package trustschema
+
+import (
+	"github.com/usnistgov/ndn-dpdk/ndn"
+)
+
+// Rule relates packet names to signer key names.
+type Rule struct {
+	// Packet is a pattern of packet names.
+	// When validating a certificate, it is matched against the certificate name.
+	Packet Pattern `json:"packet"`
+
+	// Signer is a pattern of signer key names.
+	// It is matched against the key name derived from KeyLocator of the packet.
+	Signer Pattern `json:"signer"`
+}
+
+// Match determines whether the rule allows packetName to be signed by keyName.
+func (r Rule) Match(packetName, keyName ndn.Name) bool {
+	return r.Packet.match(packetName, Vars{}, func(vars Vars) bool {
+		return r.Signer.match(keyName, vars, func(Vars) bool { return true })
+	})
+}
+
+// Schema is a rule-based trust schema.
+//
+// A packet is allowed to be signed by a key if at least one rule matches both names.
+// In JSON, it is represented as:
+//
+//	{ "rules": [
+//	  { "packet": "/<site>/<user>/blog/<**>", "signer": "/<site>/<user>/KEY/<>" },
+//	  { "packet": "/<site>/<user>/KEY/<**>", "signer": "/<site>/KEY/<>" }
+//	] }
+type Schema struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Match determines whether the schema allows packetName to be signed by keyName.
+func (s Schema) Match(packetName, keyName ndn.Name) bool {
+	for _, r := range s.Rules {
+		if r.Match(packetName, keyName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package trustschema
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+)
+
+// Error conditions.
+var (
+	ErrNoRule       = errors.New("trust schema does not allow the signer")
+	ErrNoAnchor     = errors.New("certificate chain does not terminate at a trust anchor")
+	ErrChainTooLong = errors.New("certificate chain is too long")
+	ErrCertName     = errors.New("retrieved certificate does not match KeyLocator")
+	ErrCertExpired  = errors.New("certificate is outside ValidityPeriod")
+)
+
+var errStopInspect = errors.New("stop inspect")
+
+// ValidatorOptions contains arguments to NewValidator function.
+type ValidatorOptions struct {
+	// Schema is the trust schema.
+	Schema Schema
+
+	// Anchors is a list of trust anchors.
+	// A certificate chain must terminate at one of these certificates.
+	Anchors []*keychain.Certificate
+
+	// Fw specifies the L3 Forwarder for retrieving certificates.
+	// Default is the default Forwarder.
+	Fw l3.Forwarder
+
+	// Retx specifies retransmission policy for retrieving certificates.
+	// Default is disabling retransmission.
+	Retx endpoint.RetxPolicy
+
+	// FetchTimeout is the timeout for retrieving each certificate.
+	// Default is 4 seconds.
+	FetchTimeout time.Duration
+
+	// MaxDepth is the maximum number of certificates to retrieve for validating a packet.
+	// Default is 8.
+	MaxDepth int
+
+	// CacheCapacity is the maximum number of certificates in the cache.
+	// When the cache is full, the least recently used certificate is evicted.
+	// Default is 256.
+	CacheCapacity int
+}
+
+func (opts *ValidatorOptions) applyDefaults() {
+	if opts.FetchTimeout <= 0 {
+		opts.FetchTimeout = 4 * time.Second
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = 8
+	}
+	if opts.CacheCapacity <= 0 {
+		opts.CacheCapacity = 256
+	}
+}
+
+// Validator verifies packets according to a trust schema.
+//
+// To verify a packet, its signer key name must be allowed by the trust schema, and the signer
+// certificate must be either a trust anchor or recursively verified in the same way.
+// Missing certificates are retrieved via endpoint.Consume, and cached after successful verification.
+// The cache holds up to CacheCapacity certificates with LRU replacement.
+// Every certificate in the chain, including the trust anchor, must be within its ValidityPeriod.
+//
+// Validator implements ndn.Verifier, so that it may be used as endpoint.ConsumerOptions.Verifier
+// or segmented.FetchOptions.Verifier.
+type Validator struct {
+	opts  ValidatorOptions
+	mutex sync.Mutex
+	cache map[string]*list.Element
+	lru   *list.List // Value is *keychain.Certificate, ordered by last use
+}
+
+var _ ndn.Verifier = (*Validator)(nil)
+
+// NewValidator creates a Validator.
+func NewValidator(opts ValidatorOptions) *Validator {
+	opts.applyDefaults()
+	return &Validator{
+		opts:  opts,
+		cache: map[string]*list.Element{},
+		lru:   list.New(),
+	}
+}
+
+// AddCert inserts a certificate into the cache, without verifying it.
+// This may be used for pre-installing intermediate certificates.
+func (v *Validator) AddCert(cert *keychain.Certificate) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	key := cert.Name().String()
+	if elem := v.cache[key]; elem != nil {
+		elem.Value = cert
+		v.lru.MoveToBack(elem)
+		return
+	}
+
+	if v.lru.Len() >= v.opts.CacheCapacity {
+		front := v.lru.Front()
+		delete(v.cache, v.lru.Remove(front).(*keychain.Certificate).Name().String())
+	}
+	v.cache[key] = v.lru.PushBack(cert)
+}
+
+// findCachedCert finds a cached certificate that matches a KeyLocator name.
+func (v *Validator) findCachedCert(klName ndn.Name) *keychain.Certificate {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	for elem := v.lru.Back(); elem != nil; elem = elem.Prev() {
+		if cert := elem.Value.(*keychain.Certificate); certMatches(cert, klName) {
+			v.lru.MoveToBack(elem)
+			return cert
+		}
+	}
+	return nil
+}
+
+// Verify implements ndn.Verifier interface.
+func (v *Validator) Verify(packet ndn.Verifiable) error {
+	return v.verify(packet, 0)
+}
+
+func (v *Validator) verify(packet ndn.Verifiable, depth int) error {
+	var name ndn.Name
+	var klName ndn.Name
+	if e := packet.VerifyWith(func(n ndn.Name, si ndn.SigInfo) (ndn.LLVerify, error) {
+		name, klName = n, si.KeyLocator.Name
+		return nil, errStopInspect
+	}); e != errStopInspect {
+		return e
+	}
+
+	if !keychain.IsKeyName(klName) && !keychain.IsCertName(klName) {
+		return ndn.ErrKeyLocator
+	}
+	if !v.opts.Schema.Match(name, keychain.ToKeyName(klName)) {
+		return ErrNoRule
+	}
+
+	cert, e := v.findCert(klName, depth)
+	if e != nil {
+		return e
+	}
+	return cert.PublicKey().Verify(packet)
+}
+
+// findCert returns a trusted certificate that matches a KeyLocator name.
+func (v *Validator) findCert(klName ndn.Name, depth int) (*keychain.Certificate, error) {
+	now := time.Now()
+	if cert := findMatchingCert(v.opts.Anchors, klName); cert != nil {
+		return checkValidity(cert, now)
+	}
+
+	if cert := v.findCachedCert(klName); cert != nil {
+		return checkValidity(cert, now)
+	}
+
+	if depth >= v.opts.MaxDepth {
+		return nil, ErrChainTooLong
+	}
+	cert, e := v.fetchCert(klName)
+	if e != nil {
+		return nil, e
+	}
+	if _, e := checkValidity(cert, now); e != nil {
+		return nil, e
+	}
+	if cert.SelfSigned() {
+		return nil, ErrNoAnchor
+	}
+	if e := v.verify(cert.Data(), depth+1); e != nil {
+		return nil, e
+	}
+	v.AddCert(cert)
+	return cert, nil
+}
+
+func (v *Validator) fetchCert(klName ndn.Name) (*keychain.Certificate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), v.opts.FetchTimeout)
+	defer cancel()
+
+	interest := ndn.MakeInterest(klName, ndn.CanBePrefixFlag, ndn.MustBeFreshFlag)
+	data, e := endpoint.Consume(ctx, interest, endpoint.ConsumerOptions{
+		Fw:   v.opts.Fw,
+		Retx: v.opts.Retx,
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	cert, e := keychain.CertFromData(*data)
+	if e != nil {
+		return nil, e
+	}
+	if !klName.IsPrefixOf(cert.Name()) {
+		return nil, ErrCertName
+	}
+	return cert, nil
+}
+
+// findMatchingCert finds a certificate whose name equals klName or whose key name equals klName.
+func findMatchingCert(list []*keychain.Certificate, klName ndn.Name) *keychain.Certificate {
+	for _, cert := range list {
+		if certMatches(cert, klName) {
+			return cert
+		}
+	}
+	return nil
+}
+
+func certMatches(cert *keychain.Certificate, klName ndn.Name) bool {
+	if keychain.IsCertName(klName) {
+		return cert.Name().Equal(klName)
+	}
+	return keychain.ToKeyName(cert.Name()).Equal(klName)
+}
+
+func checkValidity(cert *keychain.Certificate, now time.Time) (*keychain.Certificate, error) {
+	if !cert.Validity().Includes(now) {
+		return nil, ErrCertExpired
+	}
+	return cert, nil
+}
@@ -0,0 +1,160 @@
+package trustschema_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/usnistgov/ndn-dpdk/ndn"
+	"github.com/usnistgov/ndn-dpdk/ndn/endpoint"
+	"github.com/usnistgov/ndn-dpdk/ndn/keychain"
+	"github.com/usnistgov/ndn-dpdk/ndn/l3"
+	"github.com/usnistgov/ndn-dpdk/ndn/trustschema"
+)
+
+type validatorFixture struct {
+	t  testing.TB
+	fw l3.Forwarder
+
+	rootPvt  keychain.PrivateKey
+	rootCert *keychain.Certificate
+	fetched  map[string]int
+}
+
+func (f *validatorFixture) makeKey(name string, issuer keychain.PrivateKey, issuerCert *keychain.Certificate,
+	validity keychain.ValidityPeriod) (keychain.PrivateKey, *keychain.Certificate) {
+	_, require := makeAR(f.t)
+	pvt, pub, e := keychain.NewECDSAKeyPair(ndn.ParseName(name))
+	require.NoError(e)
+	cert, e := keychain.MakeCert(pub, issuer.WithKeyLocator(issuerCert.Name()), keychain.MakeCertOptions{Validity: validity})
+	require.NoError(e)
+	f.serve(cert)
+	return pvt, cert
+}
+
+func (f *validatorFixture) serve(cert *keychain.Certificate) {
+	_, require := makeAR(f.t)
+	p, e := endpoint.Produce(context.Background(), endpoint.ProducerOptions{
+		Prefix: cert.Name(),
+		Handler: func(ctx context.Context, interest ndn.Interest) (ndn.Data, error) {
+			f.fetched[keychain.ToKeyName(cert.Name()).String()]++
+			return cert.Data(), nil
+		},
+		Fw: f.fw,
+	})
+	require.NoError(e)
+	f.t.Cleanup(func() { p.Close() })
+}
+
+func newValidatorFixture(t testing.TB) (f *validatorFixture) {
+	_, require := makeAR(t)
+	f = &validatorFixture{
+		t:       t,
+		fw:      l3.NewForwarder(),
+		fetched: map[string]int{},
+	}
+
+	rootPvt, rootPub, e := keychain.NewECDSAKeyPair(ndn.ParseName("/S"))
+	require.NoError(e)
+	f.rootCert, e = keychain.MakeCert(rootPub, rootPvt, keychain.MakeCertOptions{IssuerID: keychain.ComponentSelfIssuer})
+	require.NoError(e)
+	f.rootPvt = rootPvt
+	f.serve(f.rootCert)
+	return f
+}
+
+func (f *validatorFixture) newValidator(anchors ...*keychain.Certificate) *trustschema.Validator {
+	return trustschema.NewValidator(f.validatorOptions(anchors...))
+}
+
+func (f *validatorFixture) validatorOptions(anchors ...*keychain.Certificate) trustschema.ValidatorOptions {
+	return trustschema.ValidatorOptions{
+		Schema: trustschema.Schema{
+			Rules: []trustschema.Rule{
+				{
+					Packet: trustschema.MustParsePattern("/<site>/<user>/blog/<**>"),
+					Signer: trustschema.MustParsePattern("/<site>/<user>/KEY/<>"),
+				},
+				{
+					Packet: trustschema.MustParsePattern("/<site>/<user>/KEY/<**>"),
+					Signer: trustschema.MustParsePattern("/<site>/KEY/<>"),
+				},
+			},
+		},
+		Anchors:      anchors,
+		Fw:           f.fw,
+		FetchTimeout: 200 * time.Millisecond,
+	}
+}
+
+func signData(t testing.TB, name string, signer ndn.Signer) ndn.Data {
+	_, require := makeAR(t)
+	data := ndn.MakeData(name)
+	require.NoError(signer.Sign(&data))
+	return data
+}
+
+func TestValidator(t *testing.T) {
+	assert, _ := makeAR(t)
+	f := newValidatorFixture(t)
+
+	userPvt, userCert := f.makeKey("/S/U", f.rootPvt, f.rootCert, keychain.MaxValidityPeriod)
+	userSigner := userPvt.WithKeyLocator(userCert.Name())
+	expiredPvt, expiredCert := f.makeKey("/S/E", f.rootPvt, f.rootCert, keychain.ValidityPeriod{
+		NotBefore: time.Unix(1500000000, 0),
+		NotAfter:  time.Unix(1600000000, 0),
+	})
+	otherPvt, otherPub, _ := keychain.NewECDSAKeyPair(ndn.ParseName("/T"))
+	otherCert, _ := keychain.MakeCert(otherPub, otherPvt, keychain.MakeCertOptions{IssuerID: keychain.ComponentSelfIssuer})
+	f.serve(otherCert)
+	roguePvt, rogueCert := f.makeKey("/S/R", otherPvt, otherCert, keychain.MaxValidityPeriod)
+
+	v := f.newValidator(f.rootCert)
+
+	assert.NoError(v.Verify(signData(t, "/S/U/blog/1", userSigner)))
+	assert.NoError(v.Verify(signData(t, "/S/U/blog/2", userPvt)))
+	assert.Equal(1, f.fetched[userPvt.Name().String()])
+
+	assert.ErrorIs(v.Verify(signData(t, "/S/V/blog/1", userSigner)), trustschema.ErrNoRule)
+	assert.ErrorIs(v.Verify(signData(t, "/S/U/photo/1", userSigner)), trustschema.ErrNoRule)
+	assert.ErrorIs(v.Verify(signData(t, "/S/U/blog/1", ndn.DigestSigning)), ndn.ErrKeyLocator)
+
+	forged := signData(t, "/S/U/blog/1", userSigner)
+	forged.Content = []byte("forged")
+	assert.Error(v.Verify(forged))
+
+	assert.ErrorIs(v.Verify(signData(t, "/S/E/blog/1", expiredPvt.WithKeyLocator(expiredCert.Name()))),
+		trustschema.ErrCertExpired)
+	assert.Error(v.Verify(signData(t, "/S/R/blog/1", roguePvt.WithKeyLocator(rogueCert.Name()))))
+
+	noAnchor := f.newValidator()
+	assert.ErrorIs(noAnchor.Verify(signData(t, "/S/U/blog/1", userSigner)), trustschema.ErrNoAnchor)
+	assert.Equal(2, f.fetched[userPvt.Name().String()])
+
+	missingPvt, _, _ := keychain.NewECDSAKeyPair(ndn.ParseName("/S/M"))
+	assert.Error(v.Verify(signData(t, "/S/M/blog/1", missingPvt)))
+}
+
+func TestValidatorCacheCapacity(t *testing.T) {
+	assert, _ := makeAR(t)
+	f := newValidatorFixture(t)
+
+	aPvt, aCert := f.makeKey("/S/A", f.rootPvt, f.rootCert, keychain.MaxValidityPeriod)
+	aSigner := aPvt.WithKeyLocator(aCert.Name())
+	bPvt, bCert := f.makeKey("/S/B", f.rootPvt, f.rootCert, keychain.MaxValidityPeriod)
+	bSigner := bPvt.WithKeyLocator(bCert.Name())
+
+	opts := f.validatorOptions(f.rootCert)
+	opts.CacheCapacity = 1
+	v := trustschema.NewValidator(opts)
+
+	assert.NoError(v.Verify(signData(t, "/S/A/blog/1", aSigner)))
+	assert.NoError(v.Verify(signData(t, "/S/A/blog/2", aSigner)))
+	assert.Equal(1, f.fetched[aPvt.Name().String()])
+
+	assert.NoError(v.Verify(signData(t, "/S/B/blog/1", bSigner)))
+	assert.Equal(1, f.fetched[bPvt.Name().String()])
+
+	assert.NoError(v.Verify(signData(t, "/S/A/blog/3", aSigner)))
+	assert.Equal(2, f.fetched[aPvt.Name().String()])
+}
@@ -23,6 +23,8 @@ const (
 		1 + 1 + 2 + // FragCount
 		1 + 1 + 8 + // PitToken
 		3 + 1 + 3 + 1 + 1 + // Nack
+		3 + 1 + 2 + // NextHopFaceId
+		3 + 1 + 2 + // IncomingFaceId
 		3 + 1 + 1 + // CongestionMark
 		1 + 5 // Payload TL
 
@@ -41,6 +41,8 @@ func ctestLpParse(t *testing.T) {
 			}
 			assert.EqualValues(tt.NackReason, lph.l3.nackReason, tt.Input)
 			assert.EqualValues(tt.CongMark, lph.l3.congMark, tt.Input)
+			assert.EqualValues(tt.NextHop, lph.l3.nextHopFaceID, tt.Input)
+			assert.EqualValues(tt.Incoming, lph.l3.incomingFaceID, tt.Input)
 			assert.EqualValues(tt.PayloadL, p.Len(), tt.Input)
 		}
 	}
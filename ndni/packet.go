@@ -106,6 +106,8 @@ func (pkt *Packet) ToNPacket() (npkt ndn.Packet) {
 	npkt.Lp.PitToken = pkt.PitToken()
 	npkt.Lp.NackReason = uint8(lpl3.nackReason)
 	npkt.Lp.CongMark = uint8(lpl3.congMark)
+	npkt.Lp.NextHopFaceID = uint64(lpl3.nextHopFaceID)
+	npkt.Lp.IncomingFaceID = uint64(lpl3.incomingFaceID)
 	if npkt.Lp.NackReason != 0 {
 		return *ndn.MakeNack(npkt.Interest, npkt.Lp.NackReason).ToPacket()
 	}